@@ -4,19 +4,24 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"log"
 	"os"
 	"strconv"
 	"strings"
 
 	"gitlab.ozon.dev/vldem/homework1/client/queue"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func main() {
@@ -34,7 +39,17 @@ func main() {
 		cmd = params[0]
 	}
 
-	conns, err := grpc.Dial(":"+config.GRPCPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	shutdown, err := tracing.Init(context.Background(), config.ServiceNameClient, config.TracingConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdown(context.Background())
+
+	conns, err := grpc.Dial(":"+config.GRPCPort,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -42,12 +57,95 @@ func main() {
 	client := pb.NewAdminClient(conns)
 
 	ctx := context.Background()
+	if token := os.Getenv(config.AccessTokenEnv); token != "" {
+		ctx = auth.ContextWithToken(ctx, token)
+	}
 
 	switch cmd {
+	case "login":
+		if len(params) < 3 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		response, err := client.Login(ctx, &pb.LoginRequest{
+			Email:    params[1],
+			Password: params[2],
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
+	case "refresh":
+		if len(params) < 2 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		response, err := client.Refresh(ctx, &pb.RefreshRequest{
+			RefreshToken: params[1],
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
+	case "logout":
+		if len(params) < 2 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		response, err := client.Logout(ctx, &pb.LogoutRequest{
+			RefreshToken: params[1],
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
+	case "roleList":
+		response, err := client.RoleList(ctx, &pb.RoleListRequest{})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
+	case "roleAdd":
+		if len(params) < 2 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		response, err := client.RoleCreate(ctx, &pb.RoleCreateRequest{
+			Name: params[1],
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
+	case "roleUpdate":
+		if len(params) < 3 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		id, _ := strconv.ParseUint(params[1], 10, 64)
+		response, err := client.RoleUpdate(ctx, &pb.RoleUpdateRequest{
+			Id:   id,
+			Name: params[2],
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
+	case "roleDelete":
+		if len(params) < 2 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		id, _ := strconv.ParseUint(params[1], 10, 64)
+		response, err := client.RoleDelete(ctx, &pb.RoleDeleteRequest{
+			Id: id,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
 	case "queue":
 		if err := queue.RequestProcess(ctx, params[1:]); err != nil {
 			log.Fatal(err)
 		}
+	case "dlq":
+		if err := queue.DeadLetterProcess(ctx, params[1:]); err != nil {
+			log.Fatal(err)
+		}
 	case "list":
 		var recPerPage, pageNum uint64
 		if len(params[1:]) >= 2 {
@@ -83,10 +181,16 @@ func main() {
 			log.Fatal(err)
 		}
 		log.Printf("response: [%v]", response)
+		log.Printf("page %d of %d, total users: %d, has more: %v", pageNum, response.GetPageCount(), response.GetTotalCount(), response.GetHasMore())
 	case "get":
 		id, _ := strconv.ParseUint(params[1], 10, 64)
+		var includeDeleted bool
+		if len(params) > 2 {
+			includeDeleted, _ = strconv.ParseBool(params[2])
+		}
 		response, err := client.UserGet(ctx, &pb.UserGetRequest{
-			Id: id,
+			Id:             id,
+			IncludeDeleted: includeDeleted,
 		})
 		if err != nil {
 			log.Fatal(err)
@@ -131,18 +235,58 @@ func main() {
 			})
 		}
 
+		var atomic bool
+		if len(params) > 2 {
+			atomic, _ = strconv.ParseBool(params[2])
+		}
+
 		response, err := client.UsersAdd(ctx, &pb.UsersAddRequest{
-			Users: input,
+			Users:  input,
+			Atomic: atomic,
 		})
 		if err != nil {
 			log.Fatal(err)
 		}
 		log.Printf("response: [%v]", response)
+	case "export":
+		if len(params) < 3 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		var fields []string
+		if len(params) > 3 && params[3] != "" {
+			fields = strings.Split(params[3], ",")
+		}
+		if err := exportUsers(ctx, client, params[1], params[2], fields); err != nil {
+			log.Fatal(err)
+		}
+	case "import":
+		if len(params) < 3 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		options := &pb.UsersImportRequest_Options{
+			Format: params[2],
+		}
+		if len(params) > 3 {
+			options.DryRun, _ = strconv.ParseBool(params[3])
+		}
+		if len(params) > 4 {
+			options.OnDuplicate = pb.UsersImportRequest_DuplicatePolicy(pb.UsersImportRequest_DuplicatePolicy_value[strings.ToUpper(params[4])])
+		}
+		response, err := importUsers(ctx, client, params[1], options)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
 	case "update":
 		if len(params) < 7 {
 			log.Fatal(errors.New("invalid arguments"))
 		}
 		id, _ := strconv.ParseUint(params[1], 10, 64)
+		var version *uint64
+		if len(params) > 7 {
+			value, _ := strconv.ParseUint(params[7], 10, 64)
+			version = &value
+		}
 		response, err := client.UserUpdate(ctx, &pb.UserUpdateRequest{
 			Id:          id,
 			Email:       params[2],
@@ -150,11 +294,43 @@ func main() {
 			Role:        params[4],
 			Password:    params[5],
 			Oldpassword: params[6],
+			Version:     version,
 		})
 		if err != nil {
 			log.Fatal(err)
 		}
 		log.Printf("response: [%v]", response)
+	case "patch":
+		if len(params) < 3 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		id, _ := strconv.ParseUint(params[1], 10, 64)
+		request := &pb.UserPatchRequest{
+			Id:         id,
+			UpdateMask: &fieldmaskpb.FieldMask{},
+		}
+		for _, param := range params[2:] {
+			field, value, _ := strings.Cut(param, "=")
+			switch field {
+			case "email":
+				request.Email = value
+			case "name":
+				request.Name = value
+			case "role":
+				request.Role = value
+			case "password":
+				request.Password = value
+			case "oldpassword":
+				request.Oldpassword = value
+				continue
+			}
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, field)
+		}
+		response, err := client.UserPatch(ctx, request)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
 	case "delete":
 		if len(params) < 3 {
 			log.Fatal(errors.New("invalid arguments"))
@@ -168,6 +344,124 @@ func main() {
 			log.Fatal(err)
 		}
 		log.Printf("response: [%v]", response)
+	case "restore":
+		if len(params) < 2 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		id, _ := strconv.ParseUint(params[1], 10, 64)
+		response, err := client.UserRestore(ctx, &pb.UserRestoreRequest{
+			Id: id,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
+	case "purge":
+		if len(params) < 2 {
+			log.Fatal(errors.New("invalid arguments"))
+		}
+		id, _ := strconv.ParseUint(params[1], 10, 64)
+		response, err := client.UserPurge(ctx, &pb.UserPurgeRequest{
+			Id: id,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
+	case "audit":
+		var recPerPage, pageNum, targetId uint64
+		if len(params[1:]) >= 2 {
+			recPerPage, _ = strconv.ParseUint(params[1], 10, 64)
+			pageNum, _ = strconv.ParseUint(params[2], 10, 64)
+		}
+		if recPerPage == 0 {
+			recPerPage = config.DefaultRecPerPage
+		}
+		if pageNum == 0 {
+			pageNum = config.DefaultPageNum
+		}
+		if len(params[1:]) >= 3 {
+			targetId, _ = strconv.ParseUint(params[3], 10, 64)
+		}
+
+		response, err := client.AuditList(ctx, &pb.AuditListRequest{
+			RecPerPage: &recPerPage,
+			PageNum:    &pageNum,
+			Filter: &pb.AuditListRequest_Filter{
+				TargetId: targetId,
+			},
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("response: [%v]", response)
+	}
+
+}
+
+// exportUsers writes the streamed file to the given path
+func exportUsers(ctx context.Context, client pb.AdminClient, path, format string, fields []string) error {
+	stream, err := client.UsersExport(ctx, &pb.UsersExportRequest{
+		Format: format,
+		Fields: fields,
+	})
+	if err != nil {
+		return err
 	}
 
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	for {
+		out, err := stream.Recv()
+		if err == io.EOF {
+			return file.Close()
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(out.GetData()); err != nil {
+			return err
+		}
+	}
+}
+
+// importUsers streams the file from the given path in chunks and returns the report of import
+func importUsers(ctx context.Context, client pb.AdminClient, path string, options *pb.UsersImportRequest_Options) (*pb.UsersImportResponse, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stream, err := client.UsersImport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	buffer := make([]byte, config.FileChunkSize)
+	for {
+		n, err := file.Read(buffer)
+		if n > 0 || options != nil {
+			if sendErr := stream.Send(&pb.UsersImportRequest{
+				Options: options,
+				Data:    buffer[:n],
+			}); sendErr != nil {
+				if sendErr == io.EOF {
+					break
+				}
+				return nil, sendErr
+			}
+			options = nil
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
 }
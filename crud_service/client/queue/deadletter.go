@@ -0,0 +1,254 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
+)
+
+// DeadLetterProcess inspects and replays dead-lettered messages:
+//
+//	list - prints all messages of the dead-letter topic
+//	replay;<partition>;<offset> - sends the message back to the topic it failed in
+//	replay;all - sends back all messages which are not replayed by the previous runs of the command
+//
+// Access tokens are not retained in dead-lettered messages, so the replayed messages carry the token of the caller.
+func DeadLetterProcess(ctx context.Context, params []string) error {
+	if len(params) == 0 {
+		return errors.New("command is not set")
+	}
+
+	token, _ := auth.TokenFromContext(ctx)
+
+	cfg := sarama.NewConfig()
+	cfg.Version = sarama.V2_0_0_0
+	cfg.Producer.Return.Successes = true
+	client, err := sarama.NewClient(config.Brokers, cfg)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	switch params[0] {
+	case "list":
+		return readDeadLetters(ctx, client, nil, func(msg *sarama.ConsumerMessage) error {
+			loggerPkg.Logger.Log.Info(formatDeadLetter(msg))
+			return nil
+		})
+	case "replay":
+		if len(params) < 2 {
+			return errors.New("invalid arguments")
+		}
+		producer, err := sarama.NewSyncProducerFromClient(client)
+		if err != nil {
+			return err
+		}
+		defer producer.Close()
+
+		if params[1] == "all" {
+			offsets, err := sarama.NewOffsetManagerFromClient(config.ConsumerGroupDeadLetterReplay, client)
+			if err != nil {
+				return err
+			}
+			// offsets of replayed messages are committed on close
+			defer offsets.Close()
+
+			return readDeadLetters(ctx, client, offsets, func(msg *sarama.ConsumerMessage) error {
+				return replay(producer, msg, token)
+			})
+		}
+		if len(params) < 3 {
+			return errors.New("invalid arguments")
+		}
+		partition, err := strconv.ParseInt(params[1], 10, 32)
+		if err != nil {
+			return errors.Wrap(err, "parsing partition")
+		}
+		offset, err := strconv.ParseInt(params[2], 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "parsing offset")
+		}
+		msg, err := readDeadLetter(ctx, client, int32(partition), offset)
+		if err != nil {
+			return err
+		}
+		return replay(producer, msg, token)
+	default:
+		return errors.Errorf("unknown command [%s]", params[0])
+	}
+}
+
+// readDeadLetters calls handle for the messages present in the dead-letter topic when it is called.
+// If offsets are set, the messages handled by the previous calls are skipped and the handled ones are marked.
+func readDeadLetters(ctx context.Context, client sarama.Client, offsets sarama.OffsetManager, handle func(msg *sarama.ConsumerMessage) error) error {
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	partitions, err := client.Partitions(config.TopicDeadLetter)
+	if err != nil {
+		return err
+	}
+	for _, partition := range partitions {
+		if err := readDeadLetterPartition(ctx, client, consumer, offsets, partition, handle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readDeadLetterPartition(ctx context.Context, client sarama.Client, consumer sarama.Consumer, offsets sarama.OffsetManager,
+	partition int32, handle func(msg *sarama.ConsumerMessage) error) error {
+	newest, err := client.GetOffset(config.TopicDeadLetter, partition, sarama.OffsetNewest)
+	if err != nil {
+		return err
+	}
+	start, err := client.GetOffset(config.TopicDeadLetter, partition, sarama.OffsetOldest)
+	if err != nil {
+		return err
+	}
+
+	var marks sarama.PartitionOffsetManager
+	if offsets != nil {
+		if marks, err = offsets.ManagePartition(config.TopicDeadLetter, partition); err != nil {
+			return err
+		}
+		// the offsets are committed by the manager, so the partition is not waited for
+		defer marks.AsyncClose()
+
+		// the offset is below the oldest one if nothing is marked yet or the marked messages are deleted
+		if next, _ := marks.NextOffset(); next > start {
+			start = next
+		}
+	}
+	if start >= newest {
+		return nil
+	}
+
+	partitionConsumer, err := consumer.ConsumePartition(config.TopicDeadLetter, partition, start)
+	if err != nil {
+		return err
+	}
+	defer partitionConsumer.Close()
+
+	for offset := start; offset < newest; {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg := <-partitionConsumer.Messages():
+			offset = msg.Offset + 1
+			if err := handle(msg); err != nil {
+				return err
+			}
+			if marks != nil {
+				marks.MarkOffset(offset, "")
+			}
+		}
+	}
+	return nil
+}
+
+// readDeadLetter returns the message at the offset of the partition of the dead-letter topic,
+// the message must be present in the partition, so that reading does not wait for new messages
+func readDeadLetter(ctx context.Context, client sarama.Client, partition int32, offset int64) (*sarama.ConsumerMessage, error) {
+	newest, err := client.GetOffset(config.TopicDeadLetter, partition, sarama.OffsetNewest)
+	if err != nil {
+		return nil, err
+	}
+	oldest, err := client.GetOffset(config.TopicDeadLetter, partition, sarama.OffsetOldest)
+	if err != nil {
+		return nil, err
+	}
+	if offset < oldest || offset >= newest {
+		return nil, errors.Errorf("message %v:%v does not exist", partition, offset)
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, err
+	}
+	defer consumer.Close()
+
+	partitionConsumer, err := consumer.ConsumePartition(config.TopicDeadLetter, partition, offset)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading message %v:%v", partition, offset)
+	}
+	defer partitionConsumer.Close()
+
+	timer := time.NewTimer(config.QueueRequestTimeout)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case err := <-partitionConsumer.Errors():
+		return nil, errors.Wrapf(err, "reading message %v:%v", partition, offset)
+	case msg := <-partitionConsumer.Messages():
+		return msg, nil
+	case <-timer.C:
+		return nil, errors.Errorf("reading message %v:%v: timeout", partition, offset)
+	}
+}
+
+// replay sends the message to the topic it failed in, headers of the failure are dropped
+func replay(producer sarama.SyncProducer, msg *sarama.ConsumerMessage, token string) error {
+	replayed, err := replayMessage(msg, token)
+	if err != nil {
+		return err
+	}
+	if _, _, err := producer.SendMessage(replayed); err != nil {
+		return err
+	}
+	loggerPkg.Logger.Log.Info(fmt.Sprintf("message %v:%v replayed to %v", msg.Partition, msg.Offset, replayed.Topic))
+	return nil
+}
+
+// replayMessage returns the message sending the dead-lettered one back to the topic it failed in.
+// The token, if any, replaces the access token of the message, since the original one has expired by the replay.
+func replayMessage(msg *sarama.ConsumerMessage, token string) (*sarama.ProducerMessage, error) {
+	var topic string
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers)+1)
+	for _, header := range msg.Headers {
+		key := string(header.Key)
+		if key == queuePkg.HeaderTopic {
+			topic = string(header.Value)
+		}
+		if !strings.HasPrefix(key, queuePkg.HeaderPrefix) && key != queuePkg.HeaderAccessToken {
+			headers = append(headers, *header)
+		}
+	}
+	if topic == "" {
+		return nil, errors.Errorf("message %v:%v has no original topic", msg.Partition, msg.Offset)
+	}
+	if token != "" {
+		headers = append(headers, sarama.RecordHeader{Key: []byte(queuePkg.HeaderAccessToken), Value: []byte(token)})
+	}
+
+	return &sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	}, nil
+}
+
+func formatDeadLetter(msg *sarama.ConsumerMessage) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v:%v key: [%s] value: [%s]", msg.Partition, msg.Offset, msg.Key, msg.Value)
+	for _, header := range msg.Headers {
+		if strings.HasPrefix(string(header.Key), queuePkg.HeaderPrefix) {
+			fmt.Fprintf(&b, " %s: [%s]", strings.TrimPrefix(string(header.Key), queuePkg.HeaderPrefix), header.Value)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,222 @@
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
+)
+
+func TestReplayMessage(t *testing.T) {
+	deadLetter := func(headers map[string]string) *sarama.ConsumerMessage {
+		msg := &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value"), Partition: 1, Offset: 10}
+		for key, value := range headers {
+			msg.Headers = append(msg.Headers, &sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+		}
+		return msg
+	}
+	headers := func(msg *sarama.ProducerMessage) map[string]string {
+		result := make(map[string]string, len(msg.Headers))
+		for _, header := range msg.Headers {
+			result[string(header.Key)] = string(header.Value)
+		}
+		return result
+	}
+
+	t.Run("fresh token", func(t *testing.T) {
+		// arrange
+		msg := deadLetter(map[string]string{
+			"request-id":               "1",
+			queuePkg.HeaderTopic:       config.TopicUIRequest,
+			queuePkg.HeaderError:       "broker is unavailable",
+			queuePkg.HeaderAccessToken: "expired",
+		})
+
+		// act
+		replayed, err := replayMessage(msg, "fresh")
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, config.TopicUIRequest, replayed.Topic)
+		assert.Equal(t, map[string]string{
+			"request-id":               "1",
+			queuePkg.HeaderAccessToken: "fresh",
+		}, headers(replayed))
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		// arrange
+		msg := deadLetter(map[string]string{queuePkg.HeaderTopic: config.TopicUIRequest})
+
+		// act
+		replayed, err := replayMessage(msg, "")
+
+		// assert
+		require.NoError(t, err)
+		assert.Empty(t, headers(replayed))
+	})
+
+	t.Run("no original topic", func(t *testing.T) {
+		// arrange
+		msg := deadLetter(map[string]string{"request-id": "1"})
+
+		// act
+		_, err := replayMessage(msg, "fresh")
+
+		// assert
+		assert.Error(t, err)
+	})
+}
+
+// deadLetterBroker returns the client of the broker keeping messages at offsets 5-6 of the partition 0 of the dead-letter topic
+func deadLetterBroker(t *testing.T) sarama.Client {
+	b := sarama.NewMockBroker(t, 1)
+	t.Cleanup(b.Close)
+	b.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(b.Addr(), b.BrokerID()).
+			SetLeader(config.TopicDeadLetter, 0, b.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetOffset(config.TopicDeadLetter, 0, sarama.OffsetOldest, 5).
+			SetOffset(config.TopicDeadLetter, 0, sarama.OffsetNewest, 7),
+		"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			SetMessage(config.TopicDeadLetter, 0, 5, sarama.StringEncoder("first")).
+			SetMessage(config.TopicDeadLetter, 0, 6, sarama.StringEncoder("second")),
+	})
+
+	client, err := sarama.NewClient([]string{b.Addr()}, sarama.NewConfig())
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestReadDeadLetter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		client := deadLetterBroker(t)
+
+		// act
+		msg, err := readDeadLetter(ctx, client, 0, 6)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), msg.Offset)
+		assert.Equal(t, []byte("second"), msg.Value)
+	})
+
+	t.Run("offset is not written yet", func(t *testing.T) {
+		// arrange
+		client := deadLetterBroker(t)
+
+		// act
+		_, err := readDeadLetter(ctx, client, 0, 7)
+
+		// assert
+		assert.EqualError(t, err, "message 0:7 does not exist")
+	})
+
+	t.Run("offset is deleted", func(t *testing.T) {
+		// arrange
+		client := deadLetterBroker(t)
+
+		// act
+		_, err := readDeadLetter(ctx, client, 0, 4)
+
+		// assert
+		assert.EqualError(t, err, "message 0:4 does not exist")
+	})
+}
+
+// replayOffsets keeps offsets of the dead-letter topic marked by the replay group
+type replayOffsets struct {
+	next map[int32]int64
+}
+
+func (o *replayOffsets) ManagePartition(_ string, partition int32) (sarama.PartitionOffsetManager, error) {
+	return &replayPartitionOffsets{offsets: o, partition: partition}, nil
+}
+
+func (o *replayOffsets) Close() error {
+	return nil
+}
+
+type replayPartitionOffsets struct {
+	sarama.PartitionOffsetManager
+	offsets   *replayOffsets
+	partition int32
+}
+
+func (p *replayPartitionOffsets) NextOffset() (int64, string) {
+	if next, ok := p.offsets.next[p.partition]; ok {
+		return next, ""
+	}
+	return sarama.OffsetNewest, ""
+}
+
+func (p *replayPartitionOffsets) MarkOffset(offset int64, _ string) {
+	p.offsets.next[p.partition] = offset
+}
+
+func (p *replayPartitionOffsets) AsyncClose() {}
+
+func TestReadDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	read := func(t *testing.T, offsets sarama.OffsetManager) []string {
+		var values []string
+		err := readDeadLetters(ctx, deadLetterBroker(t), offsets, func(msg *sarama.ConsumerMessage) error {
+			values = append(values, string(msg.Value))
+			return nil
+		})
+		require.NoError(t, err)
+		return values
+	}
+
+	t.Run("all messages", func(t *testing.T) {
+		// act
+		values := read(t, nil)
+
+		// assert
+		assert.Equal(t, []string{"first", "second"}, values)
+	})
+
+	t.Run("nothing is replayed yet", func(t *testing.T) {
+		// arrange
+		offsets := &replayOffsets{next: map[int32]int64{}}
+
+		// act
+		values := read(t, offsets)
+
+		// assert
+		assert.Equal(t, []string{"first", "second"}, values)
+		assert.Equal(t, int64(7), offsets.next[0])
+	})
+
+	t.Run("replayed messages are skipped", func(t *testing.T) {
+		// arrange
+		offsets := &replayOffsets{next: map[int32]int64{0: 6}}
+
+		// act
+		values := read(t, offsets)
+
+		// assert
+		assert.Equal(t, []string{"second"}, values)
+		assert.Equal(t, int64(7), offsets.next[0])
+	})
+
+	t.Run("all messages are replayed", func(t *testing.T) {
+		// arrange
+		offsets := &replayOffsets{next: map[int32]int64{0: 7}}
+
+		// act
+		values := read(t, offsets)
+
+		// assert
+		assert.Empty(t, values)
+	})
+}
@@ -4,67 +4,71 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"strconv"
 
 	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/protobuf/proto"
 )
 
-type message struct {
-	Command     string `json:"command"`
-	RequestData interface{}
-}
+func RequestProcess(ctx context.Context, params []string) error {
+	cfg := sarama.NewConfig()
+	cfg.Version = sarama.V2_0_0_0
+	b, err := broker.NewKafka(config.Brokers, cfg)
+	if err != nil {
+		return err
+	}
+	defer b.Close()
 
-type Consumer struct {
-	Id string
+	_, err = Request(ctx, b, params)
+	return err
 }
 
-func (c *Consumer) Setup(session sarama.ConsumerGroupSession) error {
-	return nil
-}
+// Request sends the request built from parameters of the CLI over the broker and returns the reply
+func Request(ctx context.Context, b queuePkg.Broker, params []string) (proto.Message, error) {
+	if len(params) == 0 {
+		return nil, errors.New("command is not set")
+	}
+	command, data, err := request(params)
+	if err != nil {
+		return nil, err
+	}
 
-func (c *Consumer) Cleanup(session sarama.ConsumerGroupSession) error {
-	return nil
-}
+	requests, err := queuePkg.NewClient(b, config.TopicClientRequest, config.TopicUIResponse, config.QueueRequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer requests.Close()
 
-func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for {
-		select {
-		case <-session.Context().Done():
-			return nil
-		case msg, ok := <-claim.Messages():
-			if !ok {
-				loggerPkg.Logger.Log.Info("Data channel closed")
-				return nil
-			}
-			if c.Id == string(msg.Key) {
-				session.MarkMessage(msg, "")
-				loggerPkg.Logger.Log.Info(fmt.Sprintf("response: [%v] for request Id [%v]", string(msg.Value), string(msg.Key)))
-			}
-			return nil
-		}
+	response := responses[command]()
+	if err := requests.Do(ctx, command, data, response); err != nil {
+		loggerPkg.Logger.Log.Info(fmt.Sprintf("%s failed: [%v]", command, err))
+		return nil, err
 	}
+	loggerPkg.Logger.Log.Info(fmt.Sprintf("%s response: [%v]", command, response))
+	return response, nil
 }
 
-func RequestProcess(ctx context.Context, params []string) error {
-	var msg []byte
-	cmd := params[0]
-
-	brokers := config.Brokers
-	cfg := sarama.NewConfig()
-	cfg.Version = sarama.V2_0_0_0
-	cfg.Producer.Return.Successes = true
-	cfg.Consumer.Offsets.Initial = sarama.OffsetOldest
-	syncProducer, err := sarama.NewSyncProducer(brokers, cfg)
-	if err != nil {
-		return err
-	}
+// responses returns the message of the reply to the command
+var responses = map[string]func() proto.Message{
+	queuePkg.CommandUserCreate: func() proto.Message { return &pb.BackendUserCreateResponse{} },
+	queuePkg.CommandUserGet:    func() proto.Message { return &pb.BackendUserGetResponse{} },
+	queuePkg.CommandUserList:   func() proto.Message { return &pb.BackendUserListResponse{} },
+	queuePkg.CommandUserUpdate: func() proto.Message { return &pb.BackendUserUpdateResponse{} },
+	queuePkg.CommandUserDelete: func() proto.Message { return &pb.BackendUserDeleteResponse{} },
+	queuePkg.CommandUsersAdd:   func() proto.Message { return &pb.QueueResponse_UsersAddResults{} },
+}
 
-	switch cmd {
+// request returns the command and data of the request built from parameters of the CLI,
+// they are the same as parameters of the commands calling the gRPC methods
+func request(params []string) (string, proto.Message, error) {
+	switch params[0] {
 	case "list":
 		var recPerPage, pageNum uint64
 		if len(params[1:]) >= 2 {
@@ -87,61 +91,81 @@ func RequestProcess(ctx context.Context, params []string) error {
 			sortingOrder.Field = params[3]
 			sortingOrder.Descending = descending
 		}
-		msg, err = json.Marshal(&message{
-			Command: "UserList",
-			RequestData: &pb.UserListRequest{
-				RecPerPage: &recPerPage,
-				PageNum:    &pageNum,
-				Order: &pb.UserListRequest_SortingOrder{
-					Field:      sortingOrder.Field,
-					Descending: sortingOrder.Descending,
-				},
+		return queuePkg.CommandUserList, &pb.UserListRequest{
+			RecPerPage: &recPerPage,
+			PageNum:    &pageNum,
+			Order: &pb.UserListRequest_SortingOrder{
+				Field:      sortingOrder.Field,
+				Descending: sortingOrder.Descending,
 			},
-		})
-
-		if err != nil {
-			return err
+		}, nil
+	case "get":
+		if len(params) < 2 {
+			return "", nil, errors.New("invalid arguments")
 		}
-
-	}
-
-	consumer := &Consumer{
-		Id: randStringBytes(16),
-	}
-
-	par, off, err := syncProducer.SendMessage(&sarama.ProducerMessage{
-		Topic: config.TopicClientRequest,
-		Key:   sarama.ByteEncoder(consumer.Id),
-		Value: sarama.ByteEncoder(msg),
-	})
-	logMsg := fmt.Sprintf("%v -> %v; %v", par, off, err)
-	loggerPkg.Logger.Log.Info(logMsg)
-	if err != nil {
-		return err
-	}
-
-	//cfg.Consumer.Offsets.Initial = sarama.OffsetOldest
-
-	client, err := sarama.NewConsumerGroup(brokers, config.ConsumerGroupUI, cfg)
-	if err != nil {
-		return err
-	}
-	// for {
-	if err := client.Consume(ctx, []string{config.TopicUIResponse}, consumer); err != nil {
-		loggerPkg.Logger.Log.Error(fmt.Sprintf("on consume: %v", err))
-		//time.Sleep(time.Second * 10)
-		return err
-	}
-	// }
-	return nil
-}
-
-func randStringBytes(n int) string {
-	const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
-
-	b := make([]byte, n)
-	for i := range b {
-		b[i] = letterBytes[rand.Intn(len(letterBytes))]
+		id, _ := strconv.ParseUint(params[1], 10, 64)
+		var includeDeleted bool
+		if len(params) > 2 {
+			includeDeleted, _ = strconv.ParseBool(params[2])
+		}
+		return queuePkg.CommandUserGet, &pb.UserGetRequest{
+			Id:             id,
+			IncludeDeleted: includeDeleted,
+		}, nil
+	case "add":
+		if len(params) < 5 {
+			return "", nil, errors.New("invalid arguments")
+		}
+		return queuePkg.CommandUserCreate, &pb.UserCreateRequest{
+			Email:    params[1],
+			Name:     params[2],
+			Role:     params[3],
+			Password: params[4],
+		}, nil
+	case "addList":
+		if len(params) < 2 {
+			return "", nil, errors.New("invalid arguments")
+		}
+		var users []*pb.UsersAddRequest_User
+		if err := json.Unmarshal([]byte(params[1]), &users); err != nil {
+			return "", nil, err
+		}
+		var atomic bool
+		if len(params) > 2 {
+			atomic, _ = strconv.ParseBool(params[2])
+		}
+		return queuePkg.CommandUsersAdd, &pb.UsersAddRequest{
+			Users:  users,
+			Atomic: atomic,
+		}, nil
+	case "update":
+		if len(params) < 7 {
+			return "", nil, errors.New("invalid arguments")
+		}
+		id, _ := strconv.ParseUint(params[1], 10, 64)
+		var version *uint64
+		if len(params) > 7 {
+			value, _ := strconv.ParseUint(params[7], 10, 64)
+			version = &value
+		}
+		return queuePkg.CommandUserUpdate, &pb.UserUpdateRequest{
+			Id:          id,
+			Email:       params[2],
+			Name:        params[3],
+			Role:        params[4],
+			Password:    params[5],
+			Oldpassword: params[6],
+			Version:     version,
+		}, nil
+	case "delete":
+		if len(params) < 3 {
+			return "", nil, errors.New("invalid arguments")
+		}
+		id, _ := strconv.ParseUint(params[1], 10, 64)
+		return queuePkg.CommandUserDelete, &pb.UserDeleteRequest{
+			Id:       id,
+			Password: params[2],
+		}, nil
 	}
-	return string(b)
+	return "", nil, errors.Errorf("unknown command [%s]", params[0])
 }
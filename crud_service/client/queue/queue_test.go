@@ -0,0 +1,333 @@
+package queue
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	backendQueue "gitlab.ozon.dev/vldem/homework1/cmd/backend/queue"
+	botQueue "gitlab.ozon.dev/vldem/homework1/cmd/bot/queue"
+	apiPkg "gitlab.ozon.dev/vldem/homework1/internal/api/backend"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	rolePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// recorder keeps spans of services, the tracer provider is set once as tracers of packages are bound to it
+var recorder = tracetest.NewSpanRecorder()
+
+// TestMain loads the built-in roles into the registry of the validator used by the bot and records spans
+func TestMain(m *testing.M) {
+	roles := rolePkg.NewRegistry(rolePkg.SourceFunc(func(context.Context) ([]models.Role, error) {
+		return []models.Role{
+			{Id: 1, Name: models.RoleAdminName},
+			{Id: 2, Name: models.RoleUserName},
+		}, nil
+	}))
+	if err := roles.Refresh(context.Background()); err != nil {
+		panic(err)
+	}
+	validatorPkg.SetRoleRegistry(roles)
+	loggerPkg.Logger.Log = zap.NewNop()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	os.Exit(m.Run())
+}
+
+// backend creates users with sequential ids and serves the ones it has created
+type backend struct {
+	pb.UnimplementedBackendServer
+
+	mu    sync.Mutex
+	users []*pb.BackendUserCreateRequest
+}
+
+func (b *backend) UserCreate(_ context.Context, in *pb.BackendUserCreateRequest) (*pb.BackendUserCreateResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.users = append(b.users, in)
+	return &pb.BackendUserCreateResponse{Id: uint64(len(b.users))}, nil
+}
+
+func (b *backend) UserGet(_ context.Context, in *pb.BackendUserGetRequest) (*pb.BackendUserGetResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if in.GetId() == 0 || in.GetId() > uint64(len(b.users)) {
+		return nil, status.Error(codes.NotFound, "user does not exists")
+	}
+	user := b.users[in.GetId()-1]
+	return &pb.BackendUserGetResponse{Id: in.GetId(), Email: user.GetEmail(), Name: user.GetName(), Role: user.GetRole()}, nil
+}
+
+func (b *backend) UsersAdd(stream pb.Backend_UsersAddServer) error {
+	for {
+		in, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		out, _ := b.UserCreate(stream.Context(), &pb.BackendUserCreateRequest{
+			Email:    in.GetEmail(),
+			Name:     in.GetName(),
+			Role:     in.GetRole(),
+			Password: in.GetPassword(),
+		})
+		if err := stream.Send(&pb.BackendUsersAddResponse{Id: out.GetId()}); err != nil {
+			return err
+		}
+	}
+}
+
+// tokens are shared by the tests and the backend, which checks access tokens of requests
+var tokens = auth.NewTokenManager("secret", "test", time.Minute, time.Hour, nil)
+
+// services runs the bot and the backend consuming requests over the broker until the test ends
+func services(t *testing.T, b broker.Broker) *backend {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+
+	deadLetter := &queuePkg.DeadLetter{
+		P:     b,
+		Group: config.ConsumerGroupClient,
+		Retry: config.RetryCfg{Attempts: 1},
+	}
+	bot := &botQueue.Consumer{P: b, DeadLetter: deadLetter, Workers: config.QueueWorkers}
+	users := &backend{}
+	server := &backendQueue.Consumer{
+		Backend:     users,
+		Tokens:      tokens,
+		Permissions: apiPkg.Permissions,
+		DeadLetter:  deadLetter,
+		Workers:     config.QueueWorkers,
+	}
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, b.Subscribe(ctx, config.ConsumerGroupClient, []string{config.TopicClientRequest}, bot.ConsumeClaim))
+	}()
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, b.Subscribe(ctx, config.ConsumerGroupClient, []string{config.TopicUIRequest}, server.ConsumeClaim))
+	}()
+	return users
+}
+
+// withToken returns the context of the client logged in as the user with the role
+func withToken(t *testing.T, ctx context.Context, role string) context.Context {
+	pair, err := tokens.Issue(models.User{Id: 1, Email: "admin@dummy.com", Role: role})
+	require.NoError(t, err)
+	return auth.ContextWithToken(ctx, pair.AccessToken)
+}
+
+func TestRequest(t *testing.T) {
+	ctx := withToken(t, context.Background(), models.RoleAdminName)
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(4)
+		services(t, b)
+
+		// act
+		created, err := Request(ctx, b, []string{"add", "test01@dummy.com", "Test Tester", models.RoleUserName, "123456"})
+		require.NoError(t, err)
+		user, err := Request(ctx, b, []string{"get", "1"})
+
+		// assert
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(&pb.BackendUserCreateResponse{Id: 1}, created), "actual: %v", created)
+		expected := &pb.BackendUserGetResponse{Id: 1, Email: "test01@dummy.com", Name: "Test Tester", Role: models.RoleUserName}
+		assert.True(t, proto.Equal(expected, user), "actual: %v", user)
+	})
+
+	t.Run("users add", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(4)
+		services(t, b)
+
+		// act
+		response, err := Request(ctx, b, []string{"addList",
+			`[{"email":"test01@dummy.com","name":"Test Tester","role":"User","password":"123456"},` +
+				`{"email":"test02@dummy.com","name":"Test Tester","role":"User","password":"123456"}]`,
+		})
+
+		// assert
+		require.NoError(t, err)
+		expected := &pb.QueueResponse_UsersAddResults{Users: []*pb.BackendUsersAddResponse{{Id: 1}, {Id: 2}}}
+		assert.True(t, proto.Equal(expected, response), "actual: %v", response)
+	})
+
+	t.Run("concurrent clients", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(4)
+		services(t, b)
+		const clients = 10
+
+		// act
+		ids := make(chan uint64, clients)
+		var wg sync.WaitGroup
+		for n := 0; n < clients; n++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				response, err := Request(ctx, b, []string{"add", "test01@dummy.com", "Test Tester", models.RoleUserName, "123456"})
+				if assert.NoError(t, err) {
+					ids <- response.(*pb.BackendUserCreateResponse).GetId()
+				}
+			}()
+		}
+		wg.Wait()
+		close(ids)
+
+		// assert
+		seen := make(map[uint64]bool)
+		for id := range ids {
+			seen[id] = true
+		}
+		// every client got the reply to its own request
+		assert.Len(t, seen, clients)
+	})
+
+	t.Run("single trace", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(4)
+		services(t, b)
+		ctx, root := otel.Tracer("test").Start(ctx, "test")
+		defer root.End()
+
+		// act
+		_, err := Request(ctx, b, []string{"add", "test01@dummy.com", "Test Tester", models.RoleUserName, "123456"})
+
+		// assert
+		require.NoError(t, err)
+		spans := make(map[string]sdktrace.ReadOnlySpan)
+		// spans of consumers are ended after the reply is published
+		require.Eventually(t, func() bool {
+			for _, span := range recorder.Ended() {
+				if span.SpanContext().TraceID() == root.SpanContext().TraceID() {
+					spans[span.Name()] = span
+				}
+			}
+			_, client := spans["queue/"+queuePkg.CommandUserCreate]
+			_, bot := spans["queue/consume "+config.TopicClientRequest]
+			_, server := spans["queue/consume "+config.TopicUIRequest]
+			return client && bot && server
+		}, time.Second, time.Millisecond)
+		client := spans["queue/"+queuePkg.CommandUserCreate]
+		bot := spans["queue/consume "+config.TopicClientRequest]
+		server := spans["queue/consume "+config.TopicUIRequest]
+		require.NotNil(t, client)
+		require.NotNil(t, bot)
+		require.NotNil(t, server)
+		assert.Equal(t, client.SpanContext().TraceID(), bot.SpanContext().TraceID())
+		assert.Equal(t, client.SpanContext().TraceID(), server.SpanContext().TraceID())
+		assert.Equal(t, client.SpanContext().SpanID(), bot.Parent().SpanID())
+		assert.Equal(t, bot.SpanContext().SpanID(), server.Parent().SpanID())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("invalid request replied by bot", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(4)
+			services(t, b)
+
+			// act
+			_, err := Request(ctx, b, []string{"add", "", "Test Tester", models.RoleUserName, "123456"})
+
+			// assert
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Empty(t, b.Messages(config.TopicUIRequest))
+		})
+
+		t.Run("from backend", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(4)
+			services(t, b)
+
+			// act
+			_, err := Request(ctx, b, []string{"get", "10"})
+
+			// assert
+			assert.Equal(t, codes.NotFound, status.Code(err))
+			assert.Equal(t, "user does not exists", status.Convert(err).Message())
+		})
+
+		t.Run("unauthenticated request rejected by backend", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(4)
+			users := services(t, b)
+
+			// act
+			_, err := Request(context.Background(), b, []string{"add", "test01@dummy.com", "Test Tester", models.RoleUserName, "123456"})
+
+			// assert
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			users.mu.Lock()
+			defer users.mu.Unlock()
+			assert.Empty(t, users.users)
+		})
+
+		t.Run("user is not permitted to create users", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(4)
+			users := services(t, b)
+			ctx := withToken(t, context.Background(), models.RoleUserName)
+
+			// act
+			_, err := Request(ctx, b, []string{"add", "test01@dummy.com", "Test Tester", models.RoleUserName, "123456"})
+
+			// assert
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			users.mu.Lock()
+			defer users.mu.Unlock()
+			assert.Empty(t, users.users)
+		})
+
+		t.Run("invalid token", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(4)
+			services(t, b)
+			ctx := auth.ContextWithToken(context.Background(), "bad")
+
+			// act
+			_, err := Request(ctx, b, []string{"get", "1"})
+
+			// assert
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+
+		t.Run("unknown command", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(4)
+
+			// act
+			_, err := Request(ctx, b, []string{"purge", "1"})
+
+			// assert
+			assert.EqualError(t, err, "unknown command [purge]")
+			assert.Empty(t, b.Messages(config.TopicClientRequest))
+		})
+	})
+}
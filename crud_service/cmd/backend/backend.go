@@ -6,18 +6,33 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/go-redis/redis"
+	"github.com/vldem/go-code-example/golib/pkg/crypt/aescbc"
+	"gitlab.ozon.dev/vldem/homework1/cmd/backend/outbox"
 	"gitlab.ozon.dev/vldem/homework1/cmd/backend/queue"
 	apiPkg "gitlab.ozon.dev/vldem/homework1/internal/api/backend"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
 	configPkg "gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	cachePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/cache"
+	auditPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/audit"
+	outboxPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/outbox"
+	rolePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role"
 	userPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/database"
 	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/pagetoken"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/requestid"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -29,6 +44,12 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	shutdown, err := tracing.Init(ctx, config.ServiceNameBackend, config.TracingConfig)
+	if err != nil {
+		log.Fatal("can't initialize tracing", err)
+	}
+	defer shutdown(context.Background())
+
 	// connection string
 	psqlConn := fmt.Sprintf(
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
@@ -63,65 +84,186 @@ func main() {
 	config.MinConns = configPkg.MinConns
 	config.MaxConns = configPkg.MaxConns
 
-	redis := redis.NewClient(&redis.Options{
-		Addr:     configPkg.RedisConfig.Addr,
-		Password: configPkg.RedisConfig.Password,
-		DB:       configPkg.RedisConfig.DbNum,
-	})
-	_, err = redis.Ping().Result()
+	cacheMode := configPkg.CacheConfig.Mode
+	if mode := os.Getenv(configPkg.CacheModeEnv); mode != "" {
+		cacheMode = mode
+	}
+	var redisClient *redis.Client
+	if cacheMode == cachePkg.ModeRedis {
+		redisClient = redis.NewClient(&redis.Options{
+			Addr:     configPkg.RedisConfig.Addr,
+			Password: configPkg.RedisConfig.Password,
+			DB:       configPkg.RedisConfig.DbNum,
+		})
+		_, err = redisClient.Ping().Result()
+		if err != nil {
+			log.Fatal("can't connect to redis", err)
+		}
+		defer redisClient.Close()
+	}
+	cache, err := cachePkg.New(cacheMode, redisClient, configPkg.CacheConfig.MemoryCapacity)
 	if err != nil {
-		log.Fatal("can't connect to redis", err)
+		log.Fatal("can't create cache", err)
 	}
-	defer redis.Close()
+	loggerPkg.Logger.Log.Info("cache created", zap.String("mode", cacheMode))
+
+	// revoked tokens are never evicted, so without Redis they are kept in memory until they expire
+	revokedTokens := cache
+	if cacheMode != cachePkg.ModeRedis {
+		revokedTokens = cachePkg.NewLRU(0)
+	}
+
+	db := database.Trace(pool)
 
 	var user userPkg.Interface
 	{
-		user = userPkg.New(pool)
+		user = userPkg.New(db)
+	}
+
+	var role rolePkg.Interface
+	{
+		role = rolePkg.New(db)
 	}
 
-	go runQueue(ctx, user)
-	go runGRPCBackendServer(user, redis)
+	var audit auditPkg.Interface
+	{
+		audit = auditPkg.New(db)
+	}
+
+	var userEvents outboxPkg.Interface
+	{
+		userEvents = outboxPkg.New(db)
+	}
+
+	roles := rolePkg.NewRegistry(role)
+	if err := roles.Refresh(ctx); err != nil {
+		log.Fatal("can't load roles", err)
+	}
+	validatorPkg.SetRoleRegistry(roles)
+	go roles.Run(ctx, configPkg.RolesRefreshInterval)
+
+	tokens := auth.NewTokenManager(
+		configPkg.TokenSecret,
+		configPkg.TokenIssuer,
+		configPkg.AccessTokenTTL,
+		configPkg.RefreshTokenTTL,
+		auth.NewCacheRevokedTokens(revokedTokens),
+	)
+
+	if cfg := configPkg.PageTokenConfig; cfg.EncryptionKey != "" {
+		pagetoken.SetCipher(func(iv string) pagetoken.Cipher {
+			return aescbc.New(cfg.EncryptionKey, cfg.SignKey, iv)
+		})
+	}
+
+	// requests of gRPC and Kafka are served by the same implementation
+	backend := apiPkg.New(user, role, roles, audit, cache, tokens)
+
+	go runQueue(ctx, backend, tokens)
+	go runPurge(ctx, user, cache, configPkg.PurgeInterval)
+	go runOutboxRelay(ctx, userEvents)
+	go runGRPCBackendServer(backend, tokens)
 	//http server to show expvar
 	http.ListenAndServe("127.0.0.1:8089", nil)
 }
 
-func runGRPCBackendServer(user userPkg.Interface, redis *redis.Client) {
+func runGRPCBackendServer(backend pb.BackendServer, tokens *auth.TokenManager) {
 	listener, err := net.Listen("tcp", ":"+config.GRPCPortBackend)
 	if err != nil {
 		panic(err)
 	}
 
-	grpcServer := grpc.NewServer()
-	pb.RegisterBackendServer(grpcServer, apiPkg.New(user, redis))
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			otelgrpc.UnaryServerInterceptor(),
+			requestid.UnaryServerInterceptor(),
+			auth.UnaryServerInterceptor(tokens, apiPkg.Permissions.PublicMethods()...),
+			auth.AuthorizeUnaryServerInterceptor(apiPkg.Permissions),
+		),
+		grpc.ChainStreamInterceptor(
+			otelgrpc.StreamServerInterceptor(),
+			requestid.StreamServerInterceptor(),
+			auth.StreamServerInterceptor(tokens, apiPkg.Permissions.PublicMethods()...),
+			auth.AuthorizeStreamServerInterceptor(apiPkg.Permissions),
+		),
+	)
+	pb.RegisterBackendServer(grpcServer, backend)
 
 	if err = grpcServer.Serve(listener); err != nil {
 		panic(err)
 	}
 }
 
-func runQueue(ctx context.Context, user userPkg.Interface) {
-	brokers := config.Brokers
+// runPurge permanently removes users which were soft deleted longer than the retention period ago
+func runPurge(ctx context.Context, user userPkg.Interface, cache cachePkg.Interface, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		count, err := user.PurgeDeleted(ctx, time.Now().Add(-configPkg.DeletedUsersRetention))
+		if err != nil {
+			loggerPkg.Logger.Log.Error(fmt.Sprintf("error during purge of deleted users [%v]", err))
+			continue
+		}
+		if count == 0 {
+			continue
+		}
+		loggerPkg.Logger.Log.Info("deleted users purged", zap.Int64("count", count))
+
+		// lists including deleted users may still contain the purged ones
+		if err := apiPkg.UserListGeneration(cache).Bump(ctx); err != nil {
+			loggerPkg.Logger.Log.Error(fmt.Sprintf("error during cache invalidation of user lists [%v]", err))
+		}
+	}
+}
+
+// runOutboxRelay publishes events of users' changes recorded in the outbox
+func runOutboxRelay(ctx context.Context, userEvents outboxPkg.Interface) {
 	cfg := sarama.NewConfig()
 	cfg.Version = sarama.V2_0_0_0
 	cfg.Producer.Return.Successes = true
-	producer, err := sarama.NewSyncProducer(brokers, cfg)
+	// an event is removed from the outbox only after all replicas have got it
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	b, err := broker.NewKafka(config.Brokers, cfg)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
+	defer b.Close()
 
-	client, err := sarama.NewConsumerGroup(brokers, config.ConsumerGroupClient, cfg)
+	relay := &outbox.Relay{
+		P:      b,
+		Outbox: userEvents,
+	}
+	relay.Run(ctx, configPkg.OutboxRelayInterval)
+}
+
+func runQueue(ctx context.Context, backend pb.BackendServer, tokens *auth.TokenManager) {
+	cfg := sarama.NewConfig()
+	cfg.Version = sarama.V2_0_0_0
+	b, err := broker.NewKafka(config.Brokers, cfg)
 	if err != nil {
-		panic(err)
+		log.Fatal(err.Error())
 	}
+	defer b.Close()
+
 	consumer := &queue.Consumer{
-		P:    producer,
-		User: user,
+		Backend:     backend,
+		Tokens:      tokens,
+		Permissions: apiPkg.Permissions,
+		DeadLetter: &queuePkg.DeadLetter{
+			P:     b,
+			Group: config.ConsumerGroupClient,
+			Retry: config.QueueRetryConfig,
+		},
+		Workers: config.QueueWorkers,
 	}
-	for {
-		if err := client.Consume(ctx, []string{config.TopicUIRequest}, consumer); err != nil {
-			loggerPkg.Logger.Log.Error(fmt.Sprintf("on consume: %v", err))
-			time.Sleep(time.Second * 10)
-		}
+	if err := b.Subscribe(ctx, config.ConsumerGroupClient, []string{config.TopicUIRequest}, consumer.ConsumeClaim); err != nil {
+		log.Fatal(err.Error())
 	}
-
 }
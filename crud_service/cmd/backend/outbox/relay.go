@@ -0,0 +1,76 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	outboxPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/outbox"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Headers of published events
+const (
+	HeaderEventId   = "event-id"
+	HeaderEventType = "event-type"
+)
+
+// Relay moves events of users' changes from the outbox to the user_events topic.
+// Events are published one by one and keyed by id of the user, so all events of a user get
+// into the same partition in order of the changes.
+type Relay struct {
+	P      broker.Publisher
+	Outbox outboxPkg.Interface
+}
+
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// full batches mean that more events are waiting
+		for {
+			count, err := r.Outbox.Relay(ctx, config.OutboxRelayBatchSize, func(events []models.OutboxEvent) (int, error) {
+				return r.publish(ctx, events)
+			})
+			if err != nil {
+				loggerPkg.Logger.Log.Error(fmt.Sprintf("error during relay of user events [%v]", err))
+				break
+			}
+			if count < config.OutboxRelayBatchSize {
+				break
+			}
+		}
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, events []models.OutboxEvent) (int, error) {
+	for i, event := range events {
+		err := r.P.Publish(ctx, &broker.Message{
+			Topic:     config.TopicUserEvents,
+			Partition: broker.AnyPartition,
+			Key:       []byte(strconv.FormatUint(uint64(event.UserId), 10)),
+			Value:     event.Payload,
+			Headers: map[string]string{
+				HeaderEventId:   strconv.FormatUint(event.Id, 10),
+				HeaderEventType: event.Type,
+			},
+		})
+		if err != nil {
+			return i, err
+		}
+		loggerPkg.Logger.Log.Debug("user event published", zap.Uint64("event-id", event.Id), zap.String("event-type", event.Type))
+	}
+	return len(events), nil
+}
@@ -0,0 +1,76 @@
+package outbox
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// unavailable is the publisher of the broker which fails after the number of messages
+type unavailable struct {
+	broker.Publisher
+	published int
+	err       error
+}
+
+func (p *unavailable) Publish(ctx context.Context, msg *broker.Message) error {
+	if p.published == 0 {
+		return p.err
+	}
+	p.published--
+	return nil
+}
+
+func TestRelayPublish(t *testing.T) {
+	loggerPkg.Logger.Log = zap.NewNop()
+	ctx := context.Background()
+	events := []models.OutboxEvent{
+		{Id: 7, UserId: 1, Type: "USER_CREATED", Payload: []byte{1}},
+		{Id: 8, UserId: 1, Type: "USER_UPDATED", Payload: []byte{2}},
+		{Id: 9, UserId: 2, Type: "USER_DELETED", Payload: []byte{3}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		relay := &Relay{P: b}
+
+		// act
+		count, err := relay.publish(ctx, events)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		messages := b.Messages(config.TopicUserEvents)
+		require.Len(t, messages, 3)
+		for n, event := range events {
+			assert.Equal(t, event.Payload, messages[n].Value)
+			assert.Equal(t, strconv.FormatUint(uint64(event.UserId), 10), string(messages[n].Key))
+			assert.Equal(t, strconv.FormatUint(event.Id, 10), messages[n].Headers[HeaderEventId])
+			assert.Equal(t, event.Type, messages[n].Headers[HeaderEventType])
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		sendErr := errors.New("broker is not available")
+		relay := &Relay{P: &unavailable{published: 1, err: sendErr}}
+
+		// act
+		count, err := relay.publish(ctx, events)
+
+		// assert
+		assert.ErrorIs(t, err, sendErr)
+		// the event after the failed one is not sent, so events of a user are not reordered
+		assert.Equal(t, 1, count)
+	})
+}
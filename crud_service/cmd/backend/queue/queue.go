@@ -2,127 +2,176 @@ package queue
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
+	"io"
 
-	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
+	apiPkg "gitlab.ozon.dev/vldem/homework1/internal/api/backend"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
-	userPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user"
-	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
 	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-type order struct {
-	Field      string `json:"field"`
-	Descending bool   `json:"descending"`
+// Consumer serves requests by the same implementation as the gRPC server does,
+// so that they are authorized, validated, cached and audited in the same way
+type Consumer struct {
+	Backend pb.BackendServer
+	// Tokens parse the access token of the request and Permissions check the caller's access to the command
+	Tokens      *auth.TokenManager
+	Permissions auth.Permissions
+	// DeadLetter retries requests and publishes replies
+	DeadLetter *queuePkg.DeadLetter
+	// number of workers processing messages of a claim concurrently
+	Workers int
 }
 
-type messageData struct {
-	Command     string `json:"command"`
-	RequestData struct {
-		Id          int64  `json:"id"`
-		Email       string `json:"email"`
-		Name        string `json:"name"`
-		Role        string `json:"role"`
-		Password    string `json:"password"`
-		Oldpassword string `json:"oldpassword"`
-		ReqPerPage  uint64 `json:"rec_per_page"`
-		PageNum     uint64 `json:"page_num"`
-		Order       order  `json:"order"`
-	}
+// ConsumeClaim is the handler of claims of the consumer group subscribed to requests
+func (c *Consumer) ConsumeClaim(claim broker.Claim) error {
+	return queuePkg.ConsumeClaim(claim, c.Workers, c.process)
 }
 
-type queueMessage struct {
-	Command     string `json:"command"`
-	RequestData interface{}
-}
-type Consumer struct {
-	P    sarama.SyncProducer
-	User userPkg.Interface
+// process handles the message, malformed messages and the ones failed after retries are moved to the dead-letter topic
+func (c *Consumer) process(ctx context.Context, msg *broker.Message) error {
+	return c.DeadLetter.Process(ctx, msg, c.ProcessQueue)
 }
 
-func (c *Consumer) Setup(session sarama.ConsumerGroupSession) error {
-	return nil
-}
+// ProcessQueue handles the request, the reply is routed to the client by headers of the request.
+// The command is run once, only publishing of the reply is retried, so that commands changing users
+// are not repeated. The reply failed after retries is dead-lettered and the request is consumed.
+func (c *Consumer) ProcessQueue(ctx context.Context, message *broker.Message) error {
+	request, err := queuePkg.DecodeRequest(message)
+	if err != nil {
+		// the message is never processed successfully, so it must not be retried
+		return queuePkg.Permanent(errors.Wrap(err, "unmarshaling request"))
+	}
+	loggerPkg.Logger.Log.Info("Backend service",
+		zap.String("message key", string(message.Key)),
+		zap.String("command", request.Command),
+	)
 
-func (c *Consumer) Cleanup(session sarama.ConsumerGroupSession) error {
+	data, err := c.serve(ctx, message.Headers[queuePkg.HeaderAccessToken], request)
+	if err != nil {
+		loggerPkg.Logger.Log.Info("Backend service request failed",
+			zap.String("message key", string(message.Key)),
+			zap.String("command", request.Command),
+			zap.Error(err),
+		)
+	}
+	// the command is run already, so the request is consumed even if the reply is lost
+	if err := c.reply(ctx, message, request, data, err); err != nil {
+		loggerPkg.Logger.Log.Error("Backend service reply is lost",
+			zap.String("message key", string(message.Key)),
+			zap.String("command", request.Command),
+			zap.Error(err),
+		)
+	}
 	return nil
 }
 
-func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for {
-		select {
-		case <-session.Context().Done():
-			loggerPkg.Logger.Log.Info("Done")
-			return nil
-		case msg, ok := <-claim.Messages():
-			if !ok {
-				loggerPkg.Logger.Log.Info("Data channel closed")
-				return nil
-			}
-			if err := c.ProcessQueue(msg.Key, msg.Value); err != nil {
-				return err
-			}
-			session.MarkMessage(msg, "")
-			return nil
-		}
+// reply publishes the response to the request, failures of publishing are retried
+func (c *Consumer) reply(ctx context.Context, message *broker.Message, request queuePkg.Request, data proto.Message, cause error) error {
+	msg, err := queuePkg.NewResponse(request, data, cause)
+	if err != nil {
+		return errors.Wrap(err, "marshaling message")
 	}
+	return c.DeadLetter.Publish(ctx, queuePkg.Reply(message, config.TopicUIResponse, msg))
 }
 
-func (c *Consumer) ProcessQueue(key []byte, message []byte) error {
-	var requestData messageData
+// requests return the empty request of the command
+var requests = map[string]func() proto.Message{
+	queuePkg.CommandUserCreate: func() proto.Message { return &pb.BackendUserCreateRequest{} },
+	queuePkg.CommandUserGet:    func() proto.Message { return &pb.BackendUserGetRequest{} },
+	queuePkg.CommandUserList:   func() proto.Message { return &pb.BackendUserListRequest{} },
+	queuePkg.CommandUserUpdate: func() proto.Message { return &pb.BackendUserUpdateRequest{} },
+	queuePkg.CommandUserDelete: func() proto.Message { return &pb.BackendUserDeleteRequest{} },
+	queuePkg.CommandUsersAdd:   func() proto.Message { return &pb.QueueRequest_UsersAddBatch{} },
+}
 
-	if err := json.Unmarshal(message, &requestData); err != nil {
-		panic(err)
-		//return errors.Wrapf(err, "error during unmarshal request")
+// serve checks the caller's permission to call the method of the command as the gRPC server does,
+// then calls the method and returns its response
+func (c *Consumer) serve(ctx context.Context, token string, request queuePkg.Request) (proto.Message, error) {
+	newRequest, ok := requests[request.Command]
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("unknown command [%s]", request.Command))
+	}
+	in := newRequest()
+	if err := request.Decode(in); err != nil {
+		return nil, err
 	}
-	switch requestData.Command {
-	case "UserList":
-		loggerPkg.Logger.Log.Info("Backend service",
-			zap.String("message key", string(key)),
-			zap.String("command", requestData.Command),
-			zap.Uint64("rec_per_page", requestData.RequestData.ReqPerPage),
-			zap.Uint64("page_num", requestData.RequestData.PageNum),
-			zap.String("sorting field", requestData.RequestData.Order.Field),
-			zap.Bool("role", requestData.RequestData.Order.Descending),
-		)
 
-		sortingOrder := models.SortingOrder{
-			Field:      requestData.RequestData.Order.Field,
-			Descending: requestData.RequestData.Order.Descending,
-		}
-		var msg []byte
-		users, err := c.User.List(context.Background(), requestData.RequestData.ReqPerPage, requestData.RequestData.PageNum, sortingOrder)
-		if err != nil {
-			msg = []byte(status.Error(codes.Internal, err.Error()).Error())
-		} else {
-			result := make([]*pb.BackendUserListResponse_User, 0, len(users))
-			for _, user := range users {
-				result = append(result, &pb.BackendUserListResponse_User{
-					Id:    uint64(user.Id),
-					Email: user.Email,
-					Name:  user.Name,
-					Role:  user.Role,
-				})
-			}
-			msg, err = json.Marshal(result)
-			if err != nil {
-				panic(err)
-			}
-		}
-		_, _, err = c.P.SendMessage(&sarama.ProducerMessage{
-			Topic: config.TopicUIResponse,
-			Key:   sarama.ByteEncoder(key),
-			Value: sarama.ByteEncoder(msg),
-		})
-		if err != nil {
-			return errors.Wrapf(err, "sending message to %v topic", config.TopicUIResponse)
+	ctx, err := c.authenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Permissions.Authorize(ctx, apiPkg.Method(request.Command), in); err != nil {
+		return nil, err
+	}
+
+	switch in := in.(type) {
+	case *pb.BackendUserCreateRequest:
+		return c.Backend.UserCreate(ctx, in)
+	case *pb.BackendUserGetRequest:
+		return c.Backend.UserGet(ctx, in)
+	case *pb.BackendUserListRequest:
+		return c.Backend.UserList(ctx, in)
+	case *pb.BackendUserUpdateRequest:
+		return c.Backend.UserUpdate(ctx, in)
+	case *pb.BackendUserDeleteRequest:
+		return c.Backend.UserDelete(ctx, in)
+	case *pb.QueueRequest_UsersAddBatch:
+		stream := &usersAddStream{ctx: ctx, in: in.GetUsers()}
+		if err := c.Backend.UsersAdd(stream); err != nil {
+			return nil, err
 		}
+		return &pb.QueueResponse_UsersAddResults{Users: stream.out}, nil
+	}
+
+	return nil, status.Error(codes.Unimplemented, fmt.Sprintf("unknown command [%s]", request.Command))
+}
+
+// authenticate puts the identity of the access token into the context, requests without token
+// are left unauthenticated, so only public methods are permitted to them
+func (c *Consumer) authenticate(ctx context.Context, token string) (context.Context, error) {
+	if token == "" {
+		return ctx, nil
+	}
+	identity, err := c.Tokens.ParseAccess(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return auth.ContextWithIdentity(ctx, identity), nil
+}
+
+// usersAddStream passes the users of one message to UsersAdd as if they were streamed over gRPC
+type usersAddStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	in  []*pb.BackendUsersAddRequest
+	out []*pb.BackendUsersAddResponse
+}
 
+func (s *usersAddStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *usersAddStream) Recv() (*pb.BackendUsersAddRequest, error) {
+	if len(s.in) == 0 {
+		return nil, io.EOF
 	}
+	in := s.in[0]
+	s.in = s.in[1:]
+	return in, nil
+}
+
+func (s *usersAddStream) Send(out *pb.BackendUsersAddResponse) error {
+	s.out = append(s.out, out)
 	return nil
 }
@@ -0,0 +1,347 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiPkg "gitlab.ozon.dev/vldem/homework1/internal/api/backend"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// backend serves users with ids below 10 and adds users one by one
+type backend struct {
+	pb.UnimplementedBackendServer
+}
+
+func (backend) UserGet(_ context.Context, in *pb.BackendUserGetRequest) (*pb.BackendUserGetResponse, error) {
+	if in.GetId() >= 10 {
+		return nil, status.Error(codes.NotFound, "user does not exists")
+	}
+	return &pb.BackendUserGetResponse{Id: in.GetId(), Email: "test01@dummy.com"}, nil
+}
+
+func (backend) UsersAdd(stream pb.Backend_UsersAddServer) error {
+	for id := uint64(1); ; id++ {
+		in, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		response := &pb.BackendUsersAddResponse{Id: id}
+		if in.GetEmail() == "" {
+			response = &pb.BackendUsersAddResponse{Code: int32(codes.InvalidArgument), Message: "bad email <>"}
+		}
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// countingBackend counts calls of UserGet
+type countingBackend struct {
+	backend
+	calls int
+}
+
+func (b *countingBackend) UserGet(ctx context.Context, in *pb.BackendUserGetRequest) (*pb.BackendUserGetResponse, error) {
+	b.calls++
+	return b.backend.UserGet(ctx, in)
+}
+
+// flaky is the publisher of the broker which fails the first publications
+type flaky struct {
+	*broker.Memory
+	failures int
+}
+
+func (f *flaky) Publish(ctx context.Context, msg *broker.Message) error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("broker is unavailable")
+	}
+	return f.Memory.Publish(ctx, msg)
+}
+
+// replied checks the only reply published by the consumer
+func replied(t *testing.T, b *broker.Memory, expected *pb.QueueResponse) {
+	messages := b.Messages(config.TopicUIResponse)
+	require.Len(t, messages, 1)
+	response := &pb.QueueResponse{}
+	require.NoError(t, proto.Unmarshal(messages[0].Value, response))
+	assert.True(t, proto.Equal(expected, response), "expected: %v\nactual: %v", expected, response)
+}
+
+var tokens = auth.NewTokenManager("secret", "test", time.Minute, time.Hour, nil)
+
+func newConsumer(b *broker.Memory) *Consumer {
+	return &Consumer{
+		Backend:     backend{},
+		Tokens:      tokens,
+		Permissions: apiPkg.Permissions,
+		DeadLetter:  &queuePkg.DeadLetter{P: b, Group: config.ConsumerGroupClient},
+	}
+}
+
+// accessToken returns the access token of the user with the role
+func accessToken(t *testing.T, role string) string {
+	pair, err := tokens.Issue(models.User{Id: 1, Email: "test01@dummy.com", Role: role})
+	require.NoError(t, err)
+	return pair.AccessToken
+}
+
+// request returns the message of the command sent by the admin
+func request(t *testing.T, command string, data proto.Message) *broker.Message {
+	return requestWithToken(t, command, data, accessToken(t, models.RoleAdminName))
+}
+
+func requestWithToken(t *testing.T, command string, data proto.Message, token string) *broker.Message {
+	msg, err := queuePkg.NewRequest(command, "1", data)
+	require.NoError(t, err)
+	headers := map[string]string{queuePkg.HeaderContentType: queuePkg.ContentTypeProtobuf}
+	if token != "" {
+		headers[queuePkg.HeaderAccessToken] = token
+	}
+	return &broker.Message{
+		Key:     []byte("key"),
+		Value:   msg,
+		Headers: headers,
+	}
+}
+
+func TestProcessQueue(t *testing.T) {
+	loggerPkg.Logger.Log = zap.NewNop()
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		consumer := newConsumer(b)
+
+		// act
+		err := consumer.ProcessQueue(ctx, request(t, queuePkg.CommandUserGet, &pb.BackendUserGetRequest{Id: 1}))
+
+		// assert
+		require.NoError(t, err)
+		replied(t, b, &pb.QueueResponse{
+			Version:       queuePkg.Version,
+			Command:       queuePkg.CommandUserGet,
+			CorrelationId: "1",
+			Payload: &pb.QueueResponse_UserGet{UserGet: &pb.BackendUserGetResponse{
+				Id:    1,
+				Email: "test01@dummy.com",
+			}},
+		})
+	})
+
+	t.Run("user gets own record", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		consumer := newConsumer(b)
+		token := accessToken(t, models.RoleUserName)
+
+		// act
+		err := consumer.ProcessQueue(ctx, requestWithToken(t, queuePkg.CommandUserGet, &pb.BackendUserGetRequest{Id: 1}, token))
+
+		// assert
+		require.NoError(t, err)
+		replied(t, b, &pb.QueueResponse{
+			Version:       queuePkg.Version,
+			Command:       queuePkg.CommandUserGet,
+			CorrelationId: "1",
+			Payload: &pb.QueueResponse_UserGet{UserGet: &pb.BackendUserGetResponse{
+				Id:    1,
+				Email: "test01@dummy.com",
+			}},
+		})
+	})
+
+	t.Run("users add", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		consumer := newConsumer(b)
+
+		// act
+		err := consumer.ProcessQueue(ctx, request(t, queuePkg.CommandUsersAdd, &pb.QueueRequest_UsersAddBatch{
+			Users: []*pb.BackendUsersAddRequest{
+				{Email: "test01@dummy.com"},
+				{Email: ""},
+			},
+		}))
+
+		// assert
+		require.NoError(t, err)
+		replied(t, b, &pb.QueueResponse{
+			Version:       queuePkg.Version,
+			Command:       queuePkg.CommandUsersAdd,
+			CorrelationId: "1",
+			Payload: &pb.QueueResponse_UsersAdd{UsersAdd: &pb.QueueResponse_UsersAddResults{
+				Users: []*pb.BackendUsersAddResponse{
+					{Id: 1},
+					{Code: int32(codes.InvalidArgument), Message: "bad email <>"},
+				},
+			}},
+		})
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("from backend", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(1)
+			consumer := newConsumer(b)
+
+			// act
+			err := consumer.ProcessQueue(ctx, request(t, queuePkg.CommandUserGet, &pb.BackendUserGetRequest{Id: 10}))
+
+			// assert
+			require.NoError(t, err)
+			replied(t, b, &pb.QueueResponse{
+				Version:       queuePkg.Version,
+				Command:       queuePkg.CommandUserGet,
+				CorrelationId: "1",
+				Code:          int32(codes.NotFound),
+				Message:       "user does not exists",
+			})
+		})
+
+		t.Run("unauthenticated", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(1)
+			consumer := newConsumer(b)
+
+			// act
+			err := consumer.ProcessQueue(ctx, requestWithToken(t, queuePkg.CommandUserGet, &pb.BackendUserGetRequest{Id: 1}, ""))
+
+			// assert
+			require.NoError(t, err)
+			replied(t, b, &pb.QueueResponse{
+				Version:       queuePkg.Version,
+				Command:       queuePkg.CommandUserGet,
+				CorrelationId: "1",
+				Code:          int32(codes.PermissionDenied),
+				Message:       "permission denied",
+			})
+		})
+
+		t.Run("invalid token", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(1)
+			consumer := newConsumer(b)
+
+			// act
+			err := consumer.ProcessQueue(ctx, requestWithToken(t, queuePkg.CommandUserGet, &pb.BackendUserGetRequest{Id: 1}, "bad"))
+
+			// assert
+			require.NoError(t, err)
+			messages := b.Messages(config.TopicUIResponse)
+			require.Len(t, messages, 1)
+			response := &pb.QueueResponse{}
+			require.NoError(t, proto.Unmarshal(messages[0].Value, response))
+			assert.Equal(t, int32(codes.Unauthenticated), response.GetCode())
+		})
+
+		t.Run("user gets other user", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(1)
+			consumer := newConsumer(b)
+			token := accessToken(t, models.RoleUserName)
+
+			// act
+			err := consumer.ProcessQueue(ctx, requestWithToken(t, queuePkg.CommandUserGet, &pb.BackendUserGetRequest{Id: 2}, token))
+
+			// assert
+			require.NoError(t, err)
+			replied(t, b, &pb.QueueResponse{
+				Version:       queuePkg.Version,
+				Command:       queuePkg.CommandUserGet,
+				CorrelationId: "1",
+				Code:          int32(codes.PermissionDenied),
+				Message:       "permission denied",
+			})
+		})
+
+		t.Run("unknown command", func(t *testing.T) {
+			// arrange
+			b := broker.NewMemory(1)
+			consumer := newConsumer(b)
+
+			// act
+			err := consumer.ProcessQueue(ctx, request(t, "UserPurge", &pb.BackendUserGetRequest{Id: 1}))
+
+			// assert
+			require.NoError(t, err)
+			replied(t, b, &pb.QueueResponse{
+				Version:       queuePkg.Version,
+				Command:       "UserPurge",
+				CorrelationId: "1",
+				Code:          int32(codes.Unimplemented),
+				Message:       "unknown command [UserPurge]",
+			})
+		})
+	})
+	t.Run("reply is retried without running the command again", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		users := &countingBackend{}
+		consumer := newConsumer(b)
+		consumer.Backend = users
+		consumer.DeadLetter = &queuePkg.DeadLetter{
+			P:     &flaky{Memory: b, failures: 2},
+			Group: config.ConsumerGroupClient,
+			Retry: config.RetryCfg{Attempts: 3},
+		}
+
+		// act
+		err := consumer.process(ctx, request(t, queuePkg.CommandUserGet, &pb.BackendUserGetRequest{Id: 1}))
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, users.calls)
+		replied(t, b, &pb.QueueResponse{
+			Version:       queuePkg.Version,
+			Command:       queuePkg.CommandUserGet,
+			CorrelationId: "1",
+			Payload: &pb.QueueResponse_UserGet{UserGet: &pb.BackendUserGetResponse{
+				Id:    1,
+				Email: "test01@dummy.com",
+			}},
+		})
+		assert.Empty(t, b.Messages(config.TopicDeadLetter))
+	})
+
+	t.Run("lost reply does not run the command again", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		users := &countingBackend{}
+		consumer := newConsumer(b)
+		consumer.Backend = users
+		consumer.DeadLetter = &queuePkg.DeadLetter{
+			P:     &flaky{Memory: b, failures: 3},
+			Group: config.ConsumerGroupClient,
+			Retry: config.RetryCfg{Attempts: 3},
+		}
+
+		// act
+		err := consumer.process(ctx, request(t, queuePkg.CommandUserGet, &pb.BackendUserGetRequest{Id: 1}))
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, users.calls)
+		assert.Empty(t, b.Messages(config.TopicUIResponse))
+		messages := b.Messages(config.TopicDeadLetter)
+		require.Len(t, messages, 1)
+		assert.Equal(t, config.TopicUIResponse, messages[0].Headers[queuePkg.HeaderTopic])
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"log"
 	"net"
 	"net/http"
-	"time"
 
 	_ "net/http/pprof"
 
@@ -15,15 +14,28 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"gitlab.ozon.dev/vldem/homework1/cmd/bot/queue"
 	apiPkg "gitlab.ozon.dev/vldem/homework1/internal/api/bot"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
 	botPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot"
 	cmdAddPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command/add"
+	cmdAuditPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command/audit"
 	cmdDeletePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command/delete"
 	cmdHelpPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command/help"
 	cmdListPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command/list"
+	cmdLoginPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command/login"
+	cmdLogoutPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command/logout"
+	cmdPatchPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command/patch"
 	cmdUpdatePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command/update"
+	sessionPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/session"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	rolePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
 	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/requestid"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -42,16 +54,41 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	conns, err := grpc.Dial(":"+config.GRPCPortBackend, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	shutdown, err := tracing.Init(ctx, config.ServiceNameBot, config.TracingConfig)
+	if err != nil {
+		log.Fatal("can't initialize tracing", err)
+	}
+	defer shutdown(context.Background())
+
+	conns, err := grpc.Dial(":"+config.GRPCPortBackend,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), auth.UnaryClientInterceptor(), requestid.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor(), auth.StreamClientInterceptor(), requestid.StreamClientInterceptor()),
+	)
 	if err != nil {
 		loggerPkg.Logger.Log.Fatal(err.Error())
 	}
 
 	client := pb.NewBackendClient(conns)
 
+	// the backend may be not ready yet, so failed loading of roles is retried by the periodic refresh
+	roles := rolePkg.NewRegistry(apiPkg.RoleSource(client))
+	if err := roles.Refresh(ctx); err != nil {
+		loggerPkg.Logger.Log.Error(fmt.Sprintf("can't load roles [%v]", err))
+	}
+	validatorPkg.SetRoleRegistry(roles)
+	go roles.Run(ctx, config.RolesRefreshInterval)
+
 	var bot botPkg.Interface
 	{
-		bot = botPkg.MustNew()
+		sessions := sessionPkg.New(client)
+		bot = botPkg.MustNew(sessions)
+
+		commandLogin := cmdLoginPkg.New(client, sessions)
+		bot.RegisterHandler(commandLogin)
+
+		commandLogout := cmdLogoutPkg.New(client, sessions)
+		bot.RegisterHandler(commandLogout)
 
 		commandAdd := cmdAddPkg.New(client)
 		bot.RegisterHandler(commandAdd)
@@ -59,22 +96,32 @@ func main() {
 		commandUpdate := cmdUpdatePkg.New(client)
 		bot.RegisterHandler(commandUpdate)
 
+		commandPatch := cmdPatchPkg.New(client)
+		bot.RegisterHandler(commandPatch)
+
 		commandDelete := cmdDeletePkg.New(client)
 		bot.RegisterHandler(commandDelete)
 
 		commandList := cmdListPkg.New(client)
 		bot.RegisterHandler(commandList)
 
+		commandAudit := cmdAuditPkg.New(client)
+		bot.RegisterHandler(commandAudit)
+
 		commandHelp := cmdHelpPkg.New(map[string]string{
+			commandLogin.Name():  commandLogin.Description(),
+			commandLogout.Name(): commandLogout.Description(),
 			commandAdd.Name():    commandAdd.Description(),
 			commandUpdate.Name(): commandUpdate.Description(),
+			commandPatch.Name():  commandPatch.Description(),
 			commandDelete.Name(): commandDelete.Description(),
 			commandList.Name():   commandList.Description(),
+			commandAudit.Name():  commandAudit.Description(),
 		})
 		bot.RegisterHandler(commandHelp)
 	}
 	go runBot(ctx, bot)
-	go runGRPCServer(client)
+	go runGRPCServer(client, roles)
 	go runREST(ctx)
 	go runQueue(ctx)
 	http.ListenAndServe("127.0.0.1:8088", nil)
@@ -86,14 +133,35 @@ func runBot(ctx context.Context, bot botPkg.Interface) {
 	}
 }
 
-func runGRPCServer(client pb.BackendClient) {
+func runGRPCServer(client pb.BackendClient, roles *rolePkg.Registry) {
 	listener, err := net.Listen("tcp", ":"+config.GRPCPort)
 	if err != nil {
 		panic(err)
 	}
 
-	grpcServer := grpc.NewServer()
-	pb.RegisterAdminServer(grpcServer, apiPkg.New(client))
+	tokens := auth.NewTokenManager(
+		config.TokenSecret,
+		config.TokenIssuer,
+		config.AccessTokenTTL,
+		config.RefreshTokenTTL,
+		nil,
+	)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			otelgrpc.UnaryServerInterceptor(),
+			requestid.UnaryServerInterceptor(),
+			auth.UnaryServerInterceptor(tokens, apiPkg.Permissions.PublicMethods()...),
+			auth.AuthorizeUnaryServerInterceptor(apiPkg.Permissions),
+		),
+		grpc.ChainStreamInterceptor(
+			otelgrpc.StreamServerInterceptor(),
+			requestid.StreamServerInterceptor(),
+			auth.StreamServerInterceptor(tokens, apiPkg.Permissions.PublicMethods()...),
+			auth.AuthorizeStreamServerInterceptor(apiPkg.Permissions),
+		),
+	)
+	pb.RegisterAdminServer(grpcServer, apiPkg.New(client, roles))
 
 	if err = grpcServer.Serve(listener); err != nil {
 		panic(err)
@@ -104,13 +172,26 @@ func runREST(ctx context.Context) {
 
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(headerMatcherREST),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcherREST),
 	)
 
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
 	if err := pb.RegisterAdminHandlerFromEndpoint(ctx, mux, ":"+config.GRPCPort, opts); err != nil {
 		panic(err)
 	}
 
+	conn, err := grpc.DialContext(ctx, ":"+config.GRPCPort, opts...)
+	if err != nil {
+		panic(err)
+	}
+	if err := registerTransferHandlers(mux, pb.NewAdminClient(conn)); err != nil {
+		panic(err)
+	}
+
 	if err := http.ListenAndServe(":"+config.HTTPPort, mux); err != nil {
 		panic(err)
 	}
@@ -118,35 +199,43 @@ func runREST(ctx context.Context) {
 
 func headerMatcherREST(key string) (string, bool) {
 	switch key {
-	case "Custom":
+	case "Custom", "X-Request-Id", "If-Match":
 		return key, true
 	default:
 		return key, false
 	}
 }
 
+// outgoingHeaderMatcherREST passes version of the user as the standard HTTP header,
+// other metadata is passed with the default prefix
+func outgoingHeaderMatcherREST(key string) (string, bool) {
+	switch key {
+	case "etag":
+		return "ETag", true
+	default:
+		return runtime.MetadataHeaderPrefix + key, true
+	}
+}
+
 func runQueue(ctx context.Context) {
-	brokers := config.Brokers
 	cfg := sarama.NewConfig()
 	cfg.Version = sarama.V2_0_0_0
-	cfg.Producer.Return.Successes = true
-	producer, err := sarama.NewSyncProducer(brokers, cfg)
+	b, err := broker.NewKafka(config.Brokers, cfg)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
+	defer b.Close()
 
-	client, err := sarama.NewConsumerGroup(brokers, config.ConsumerGroupClient, cfg)
-	if err != nil {
-		panic(err)
-	}
 	consumer := &queue.Consumer{
-		P: producer,
+		P: b,
+		DeadLetter: &queuePkg.DeadLetter{
+			P:     b,
+			Group: config.ConsumerGroupClient,
+			Retry: config.QueueRetryConfig,
+		},
+		Workers: config.QueueWorkers,
 	}
-	for {
-		if err := client.Consume(ctx, []string{config.TopicClientRequest}, consumer); err != nil {
-			loggerPkg.Logger.Log.Error(fmt.Sprintf("on consume: %v", err))
-			time.Sleep(time.Second * 10)
-		}
+	if err := b.Subscribe(ctx, config.ConsumerGroupClient, []string{config.TopicClientRequest}, consumer.ConsumeClaim); err != nil {
+		log.Fatal(err.Error())
 	}
-
 }
@@ -2,141 +2,169 @@ package queue
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 
-	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
+	apiPkg "gitlab.ozon.dev/vldem/homework1/internal/api/bot"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
-	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
 	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-type order struct {
-	Field      string `json:"field"`
-	Descending bool   `json:"descending"`
-}
-
-type messageData struct {
-	Command     string `json:"command"`
-	RequestData struct {
-		Id          int64  `json:"id"`
-		Email       string `json:"email"`
-		Name        string `json:"name"`
-		Role        string `json:"role"`
-		Password    string `json:"password"`
-		Oldpassword string `json:"oldpassword"`
-		ReqPerPage  uint64 `json:"rec_per_page"`
-		PageNum     uint64 `json:"page_num"`
-		Order       order  `json:"order"`
-	}
-}
-
-type queueMessage struct {
-	Command     string `json:"command"`
-	RequestData interface{}
-}
+// Consumer validates requests of clients and passes them to the backend service,
+// invalid requests are replied by the consumer itself
 type Consumer struct {
-	P sarama.SyncProducer
+	P          broker.Publisher
+	DeadLetter *queuePkg.DeadLetter
+	// number of workers processing messages of a claim concurrently
+	Workers int
 }
 
-func (c *Consumer) Setup(session sarama.ConsumerGroupSession) error {
-	return nil
+// ConsumeClaim is the handler of claims of the consumer group subscribed to requests
+func (c *Consumer) ConsumeClaim(claim broker.Claim) error {
+	return queuePkg.ConsumeClaim(claim, c.Workers, c.process)
 }
 
-func (c *Consumer) Cleanup(session sarama.ConsumerGroupSession) error {
-	return nil
+// process handles the message, malformed messages and the ones failed after retries are moved to the dead-letter topic
+func (c *Consumer) process(ctx context.Context, msg *broker.Message) error {
+	return c.DeadLetter.Process(ctx, msg, c.ProcessQueue)
 }
 
-func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for {
-		select {
-		case <-session.Context().Done():
-			loggerPkg.Logger.Log.Info("Done")
-			return nil
-		case msg, ok := <-claim.Messages():
-			if !ok {
-				loggerPkg.Logger.Log.Info("Data channel closed")
-				return nil
-			}
-			if err := c.ProcessQueue(session.Context(), msg.Key, msg.Value); err != nil {
-				return err
-			}
-			session.MarkMessage(msg, "")
-			return nil
+// ProcessQueue handles the request, the reply is routed to the client by headers of the request.
+// The bot does not check the access token, it is forwarded with other headers to the backend.
+func (c *Consumer) ProcessQueue(ctx context.Context, message *broker.Message) error {
+	request, err := queuePkg.DecodeRequest(message)
+	if err != nil {
+		// the message is never processed successfully, so it must not be retried
+		return queuePkg.Permanent(errors.Wrap(err, "unmarshaling request"))
+	}
+	loggerPkg.Logger.Log.Info("UI service",
+		zap.String("message key", string(message.Key)),
+		zap.String("command", request.Command),
+	)
+
+	data, err := backendRequest(request)
+	if err != nil {
+		response, err := queuePkg.NewResponse(request, nil, err)
+		if err != nil {
+			return errors.Wrap(err, "marshaling message")
 		}
+		return c.P.Publish(ctx, queuePkg.Reply(message, config.TopicUIResponse, response))
 	}
-}
-
-func (c *Consumer) ProcessQueue(ctx context.Context, key []byte, message []byte) error {
-	var requestData messageData
 
-	if err := json.Unmarshal(message, &requestData); err != nil {
-		panic(err)
-		//return errors.Wrapf(err, "error during unmarshal request")
+	msg, err := queuePkg.NewRequest(request.Command, request.CorrelationId, data)
+	if err != nil {
+		return errors.Wrap(err, "marshaling message")
 	}
-	switch requestData.Command {
-	case "UserList":
-		loggerPkg.Logger.Log.Info("UI service",
-			zap.String("message key", string(key)),
-			zap.String("command", requestData.Command),
-			zap.Uint64("rec_per_page", requestData.RequestData.ReqPerPage),
-			zap.Uint64("page_num", requestData.RequestData.PageNum),
-			zap.String("sorting field", requestData.RequestData.Order.Field),
-			zap.Bool("role", requestData.RequestData.Order.Descending),
-		)
+	return c.P.Publish(ctx, queuePkg.Forward(message, config.TopicUIRequest, msg))
+}
 
-		if requestData.RequestData.Order.Field != "" {
-			err := validatorPkg.ValidateSortingField(requestData.RequestData.Order.Field)
-			if err != nil {
-				_, _, err := c.P.SendMessage(&sarama.ProducerMessage{
-					Topic: config.TopicUIResponse,
-					Key:   sarama.ByteEncoder(key),
-					Value: sarama.ByteEncoder("invalid argument"),
-				})
-				if err != nil {
-					return errors.Wrapf(err, "sending message to %v topic", config.TopicUIResponse)
-				}
-				return nil
-			}
+// backendRequest validates the request of the client as the gRPC method of the command does
+// and returns the request of the backend service
+func backendRequest(request queuePkg.Request) (proto.Message, error) {
+	switch request.Command {
+	case queuePkg.CommandUserCreate:
+		in := &pb.UserCreateRequest{}
+		if err := request.Decode(in); err != nil {
+			return nil, err
 		}
-
-		if requestData.RequestData.Order.Field == "" {
-			requestData.RequestData.Order.Field = config.DefaultSortingField
+		if err := apiPkg.ValidateUserCreate(in); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-
-		if requestData.RequestData.ReqPerPage == 0 {
-			requestData.RequestData.ReqPerPage = config.DefaultRecPerPage
+		return &pb.BackendUserCreateRequest{
+			Email:    in.GetEmail(),
+			Name:     in.GetName(),
+			Role:     in.GetRole(),
+			Password: in.GetPassword(),
+		}, nil
+
+	case queuePkg.CommandUserGet:
+		in := &pb.UserGetRequest{}
+		if err := request.Decode(in); err != nil {
+			return nil, err
 		}
-		if requestData.RequestData.PageNum == 0 {
-			requestData.RequestData.PageNum = config.DefaultPageNum
+		if err := apiPkg.ValidateUserGet(in); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-
-		msg, err := json.Marshal(&queueMessage{
-			Command: "UserList",
-			RequestData: &pb.BackendUserListRequest{
-				RecPerPage: &requestData.RequestData.ReqPerPage,
-				PageNum:    &requestData.RequestData.PageNum,
-				Order: &pb.BackendUserListRequest_SortingOrder{
-					Field:      requestData.RequestData.Order.Field,
-					Descending: requestData.RequestData.Order.Descending,
-				},
-			},
-		})
-		if err != nil {
-			return errors.Wrap(err, "marshaling message")
+		return &pb.BackendUserGetRequest{
+			Id:             in.GetId(),
+			IncludeDeleted: in.GetIncludeDeleted(),
+		}, nil
+
+	case queuePkg.CommandUserList:
+		in := &pb.UserListRequest{}
+		if err := request.Decode(in); err != nil {
+			return nil, err
 		}
-
-		_, _, err = c.P.SendMessage(&sarama.ProducerMessage{
-			Topic: config.TopicUIRequest,
-			Key:   sarama.ByteEncoder(key),
-			Value: sarama.ByteEncoder(msg),
-		})
-		if err != nil {
-			return errors.Wrapf(err, "sending message to %v topic", config.TopicUIRequest)
+		if err := apiPkg.ValidateUserList(in); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		return apiPkg.BackendUserListRequest(in), nil
 
+	case queuePkg.CommandUserUpdate:
+		in := &pb.UserUpdateRequest{}
+		if err := request.Decode(in); err != nil {
+			return nil, err
+		}
+		if err := apiPkg.ValidateUserUpdate(in); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &pb.BackendUserUpdateRequest{
+			Id:          in.GetId(),
+			Email:       in.GetEmail(),
+			Name:        in.GetName(),
+			Role:        in.GetRole(),
+			Password:    in.GetPassword(),
+			Oldpassword: in.GetOldpassword(),
+			Version:     in.Version,
+		}, nil
+
+	case queuePkg.CommandUserDelete:
+		in := &pb.UserDeleteRequest{}
+		if err := request.Decode(in); err != nil {
+			return nil, err
+		}
+		if err := apiPkg.ValidateUserDelete(in); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &pb.BackendUserDeleteRequest{
+			Id:       in.GetId(),
+			Password: in.GetPassword(),
+		}, nil
+
+	case queuePkg.CommandUsersAdd:
+		in := &pb.UsersAddRequest{}
+		if err := request.Decode(in); err != nil {
+			return nil, err
+		}
+		if len(in.GetUsers()) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "data is empty")
+		}
+		// users of the batch are sent in one message, so in non-atomic mode invalid users are sent as well
+		// and get failed results from the backend, which validates them again
+		users := make([]*pb.BackendUsersAddRequest, 0, len(in.GetUsers()))
+		for index, user := range in.GetUsers() {
+			if in.GetAtomic() {
+				if err := apiPkg.ValidateUsersAddUser(user); err != nil {
+					return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("batch item [%d]: %s", index, err.Error()))
+				}
+			}
+			users = append(users, &pb.BackendUsersAddRequest{
+				Email:    user.GetEmail(),
+				Name:     user.GetName(),
+				Role:     user.GetRole(),
+				Password: user.GetPassword(),
+				Atomic:   in.GetAtomic(),
+			})
+		}
+		return &pb.QueueRequest_UsersAddBatch{Users: users}, nil
 	}
-	return nil
+
+	return nil, status.Error(codes.Unimplemented, fmt.Sprintf("unknown command [%s]", request.Command))
 }
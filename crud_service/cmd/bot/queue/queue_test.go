@@ -0,0 +1,192 @@
+package queue
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	rolePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	queuePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/queue"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestMain loads the built-in roles into the registry of the validator
+func TestMain(m *testing.M) {
+	roles := rolePkg.NewRegistry(rolePkg.SourceFunc(func(context.Context) ([]models.Role, error) {
+		return []models.Role{
+			{Id: 1, Name: models.RoleAdminName},
+			{Id: 2, Name: models.RoleUserName},
+		}, nil
+	}))
+	if err := roles.Refresh(context.Background()); err != nil {
+		panic(err)
+	}
+	validatorPkg.SetRoleRegistry(roles)
+	loggerPkg.Logger.Log = zap.NewNop()
+
+	os.Exit(m.Run())
+}
+
+// published checks the only message published to the topic, v is filled by it
+func published(t *testing.T, b *broker.Memory, topic string, expected proto.Message, v proto.Message) {
+	messages := b.Messages(topic)
+	require.Len(t, messages, 1)
+	require.NoError(t, proto.Unmarshal(messages[0].Value, v))
+	assert.True(t, proto.Equal(expected, v), "expected: %v\nactual: %v", expected, v)
+}
+
+func request(t *testing.T, command string, data proto.Message) *broker.Message {
+	msg, err := queuePkg.NewRequest(command, "1", data)
+	require.NoError(t, err)
+	return &broker.Message{
+		Key:     []byte("key"),
+		Value:   msg,
+		Headers: map[string]string{queuePkg.HeaderContentType: queuePkg.ContentTypeProtobuf},
+	}
+}
+
+func TestProcessQueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		expected := &pb.QueueRequest{
+			Version:       queuePkg.Version,
+			Command:       queuePkg.CommandUserCreate,
+			CorrelationId: "1",
+			Payload: &pb.QueueRequest_BackendUserCreate{BackendUserCreate: &pb.BackendUserCreateRequest{
+				Email:    "test01@dummy.com",
+				Name:     "Test Tester",
+				Role:     models.RoleUserName,
+				Password: "123456",
+			}},
+		}
+		consumer := &Consumer{P: b}
+
+		// act
+		err := consumer.ProcessQueue(ctx, request(t, queuePkg.CommandUserCreate, &pb.UserCreateRequest{
+			Email:    "test01@dummy.com",
+			Name:     "Test Tester",
+			Role:     models.RoleUserName,
+			Password: "123456",
+		}))
+
+		// assert
+		require.NoError(t, err)
+		published(t, b, config.TopicUIRequest, expected, &pb.QueueRequest{})
+	})
+
+	t.Run("json request", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		expected := &pb.QueueRequest{
+			Version: queuePkg.Version,
+			Command: queuePkg.CommandUserGet,
+			Payload: &pb.QueueRequest_BackendUserGet{BackendUserGet: &pb.BackendUserGetRequest{Id: 1}},
+		}
+		consumer := &Consumer{P: b}
+
+		// act
+		err := consumer.ProcessQueue(ctx, &broker.Message{
+			Key:   []byte("key"),
+			Value: []byte(`{"command":"UserGet","RequestData":{"id":1}}`),
+		})
+
+		// assert
+		require.NoError(t, err)
+		published(t, b, config.TopicUIRequest, expected, &pb.QueueRequest{})
+	})
+
+	t.Run("malformed message", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		consumer := &Consumer{P: b}
+
+		// act
+		err := consumer.ProcessQueue(ctx, &broker.Message{Key: []byte("key"), Value: []byte("{command")})
+
+		// assert
+		assert.Error(t, err)
+		assert.True(t, queuePkg.IsPermanent(err))
+		assert.Empty(t, b.Messages(config.TopicUIResponse))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			command  string
+			data     proto.Message
+			expected *pb.QueueResponse
+		}{
+			{
+				name:    "invalid argument",
+				command: queuePkg.CommandUserDelete,
+				data:    &pb.UserDeleteRequest{Id: 1, Password: "1"},
+				expected: &pb.QueueResponse{
+					Version:       queuePkg.Version,
+					Command:       queuePkg.CommandUserDelete,
+					CorrelationId: "1",
+					Code:          int32(codes.InvalidArgument),
+					Message:       "bad password <1> (should contain <a-zA-Z0-9> length 6-15 symbols) ",
+				},
+			},
+			{
+				name:    "invalid user of atomic batch",
+				command: queuePkg.CommandUsersAdd,
+				data: &pb.UsersAddRequest{
+					Users: []*pb.UsersAddRequest_User{
+						{Email: "test01@dummy.com", Name: "Test Tester", Role: models.RoleUserName, Password: "123456"},
+						{Email: "", Name: "Test Tester", Role: models.RoleUserName, Password: "123456"},
+					},
+					Atomic: true,
+				},
+				expected: &pb.QueueResponse{
+					Version:       queuePkg.Version,
+					Command:       queuePkg.CommandUsersAdd,
+					CorrelationId: "1",
+					Code:          int32(codes.InvalidArgument),
+					Message:       "batch item [1]: bad email <>",
+				},
+			},
+			{
+				name:    "unknown command",
+				command: "UserPurge",
+				data:    &pb.UserGetRequest{Id: 1},
+				expected: &pb.QueueResponse{
+					Version:       queuePkg.Version,
+					Command:       "UserPurge",
+					CorrelationId: "1",
+					Code:          int32(codes.Unimplemented),
+					Message:       "unknown command [UserPurge]",
+				},
+			},
+		}
+		for _, c := range cases {
+			c := c
+			t.Run(c.name, func(t *testing.T) {
+				// arrange
+				b := broker.NewMemory(1)
+				consumer := &Consumer{P: b}
+
+				// act
+				err := consumer.ProcessQueue(ctx, request(t, c.command, c.data))
+
+				// assert
+				require.NoError(t, err)
+				published(t, b, config.TopicUIResponse, c.expected, &pb.QueueResponse{})
+				assert.Empty(t, b.Messages(config.TopicUIRequest))
+			})
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/userfile"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Files of bulk import and export are sent as they are, not as JSON of gRPC messages,
+// so their REST endpoints are served by the gateway itself and call the streaming methods.
+// Parameters of requests are passed in the query, e.g. ?format=csv&fields=id&fields=email&header[email]=mail
+func registerTransferHandlers(mux *runtime.ServeMux, client pb.AdminClient) error {
+	if err := mux.HandlePath(http.MethodGet, "/v1/users/export", usersExportHandler(mux, client)); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodPost, "/v1/users/import", usersImportHandler(mux, client))
+}
+
+var contentTypes = map[userfile.Format]string{
+	userfile.FormatCSV:    "text/csv",
+	userfile.FormatNDJSON: "application/x-ndjson",
+}
+
+func usersExportHandler(mux *runtime.ServeMux, client pb.AdminClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, "/"+pb.Admin_ServiceDesc.ServiceName+"/UsersExport",
+			runtime.WithHTTPPathPattern("/v1/users/export"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		in := &pb.UsersExportRequest{}
+		if err := runtime.PopulateQueryParameters(in, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		if in.Format == "" {
+			in.Format = string(userfile.FormatCSV)
+		}
+
+		stream, err := client.UsersExport(ctx, in)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+		// errors are returned with the status of the response until the first chunk is written
+		written := false
+		for {
+			out, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				if !written {
+					runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+				}
+				return
+			}
+			if !written {
+				w.Header().Set("Content-Type", contentTypes[userfile.Format(in.GetFormat())])
+				written = true
+			}
+			if _, err := w.Write(out.GetData()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func usersImportHandler(mux *runtime.ServeMux, client pb.AdminClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, "/"+pb.Admin_ServiceDesc.ServiceName+"/UsersImport",
+			runtime.WithHTTPPathPattern("/v1/users/import"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		options := &pb.UsersImportRequest_Options{}
+		if err := runtime.PopulateQueryParameters(options, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		if options.Format == "" {
+			options.Format = string(userfile.FormatCSV)
+			if r.Header.Get("Content-Type") == contentTypes[userfile.FormatNDJSON] {
+				options.Format = string(userfile.FormatNDJSON)
+			}
+		}
+
+		stream, err := client.UsersImport(ctx)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+		if err := sendFile(stream, options, r.Body); err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+		out, err := stream.CloseAndRecv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, r, out)
+	}
+}
+
+// sendFile streams the file in chunks, options are sent with the first chunk
+func sendFile(stream pb.Admin_UsersImportClient, options *pb.UsersImportRequest_Options, file io.Reader) error {
+	buffer := make([]byte, config.FileChunkSize)
+	for {
+		n, err := file.Read(buffer)
+		if n > 0 || options != nil {
+			if sendErr := stream.Send(&pb.UsersImportRequest{
+				Options: options,
+				Data:    buffer[:n],
+			}); sendErr != nil {
+				// the server has finished the stream, its status is returned by CloseAndRecv
+				if sendErr == io.EOF {
+					return nil
+				}
+				return sendErr
+			}
+			options = nil
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package backend
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditList is not cached, because every change of users adds events to the log
+func (i implementation) AuditList(ctx context.Context, in *pb.BackendAuditListRequest) (*pb.BackendAuditListResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/AuditList")
+	defer span.End()
+
+	filter := models.AuditFilter{
+		ActorId:  uint(in.GetFilter().GetActorId()),
+		TargetId: uint(in.GetFilter().GetTargetId()),
+		Actions:  in.GetFilter().GetActions(),
+	}
+	if in.GetFilter().GetFrom() != nil {
+		filter.From = in.GetFilter().GetFrom().AsTime()
+	}
+	if in.GetFilter().GetTo() != nil {
+		filter.To = in.GetFilter().GetTo().AsTime()
+	}
+	if err := validatorPkg.ValidateAuditFilter(filter); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	recPerPage := in.GetRecPerPage()
+	pageNum := in.GetPageNum()
+	if recPerPage == 0 {
+		recPerPage = config.DefaultRecPerPage
+	}
+	if pageNum == 0 {
+		pageNum = config.DefaultPageNum
+	}
+
+	events, err := i.audit.List(ctx, recPerPage, pageNum, filter)
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	totalCount, err := i.audit.Count(ctx, filter)
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	pageCount := (totalCount + recPerPage - 1) / recPerPage
+
+	result := make([]*pb.BackendAuditListResponse_Event, 0, len(events))
+	for _, event := range events {
+		changes := make(map[string]*pb.BackendAuditListResponse_FieldChange, len(event.Changes))
+		for field, change := range event.Changes {
+			changes[field] = &pb.BackendAuditListResponse_FieldChange{
+				Old: change.Old,
+				New: change.New,
+			}
+		}
+		result = append(result, &pb.BackendAuditListResponse_Event{
+			Id:         event.Id,
+			ActorId:    uint64(event.ActorId),
+			ActorEmail: event.ActorEmail,
+			Action:     event.Action,
+			TargetId:   uint64(event.TargetId),
+			Changes:    changes,
+			RequestId:  event.RequestId,
+			CreatedAt:  timestamppb.New(event.CreatedAt),
+		})
+	}
+
+	return &pb.BackendAuditListResponse{
+		Events:     result,
+		TotalCount: totalCount,
+		PageCount:  pageCount,
+		HasMore:    pageNum < pageCount,
+	}, nil
+}
@@ -2,44 +2,186 @@ package backend
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"strconv"
-	"time"
 
-	"github.com/go-redis/redis"
-	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
 	"gitlab.ozon.dev/vldem/homework1/internal/auth"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	cachePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/cache"
+	auditPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/audit"
+	rolePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role"
 	userPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	userStoragePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage/postgres"
 	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
-	"gitlab.ozon.dev/vldem/homework1/internal/pkg/counter"
 	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/pagetoken"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func New(user userPkg.Interface, redis *redis.Client) *implementation {
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/api/backend")
+
+const errMsgBadCredentials = "wrong email or password"
+
+// Permissions define who is allowed to call methods of Backend service
+var Permissions = auth.Permissions{
+	Method("Login"):       {Public: true},
+	Method("Refresh"):     {Public: true},
+	Method("Logout"):      {Public: true},
+	Method("UserCreate"):  {Roles: []string{models.RoleAdminName}},
+	Method("UsersAdd"):    {Roles: []string{models.RoleAdminName}},
+	Method("UserList"):    {Roles: []string{models.RoleAdminName}},
+	Method("UserDelete"):  {Roles: []string{models.RoleAdminName}},
+	Method("UserGet"):     {Roles: []string{models.RoleAdminName}, Self: true},
+	Method("UserUpdate"):  {Roles: []string{models.RoleAdminName}, Self: true},
+	Method("UserPatch"):   {Roles: []string{models.RoleAdminName}, Self: true},
+	Method("UserRestore"): {Roles: []string{models.RoleAdminName}},
+	Method("UserPurge"):   {Roles: []string{models.RoleAdminName}},
+	Method("RoleCreate"):  {Roles: []string{models.RoleAdminName}},
+	Method("RoleUpdate"):  {Roles: []string{models.RoleAdminName}},
+	Method("RoleDelete"):  {Roles: []string{models.RoleAdminName}},
+	Method("AuditList"):   {Roles: []string{models.RoleAdminName}},
+	Method("UsersExport"): {Roles: []string{models.RoleAdminName}},
+	Method("UsersImport"): {Roles: []string{models.RoleAdminName}},
+	// role names are not secret and the bot service loads them to validate input before any user logs in
+	Method("RoleList"): {Public: true},
+}
+
+// Method returns the full gRPC name of the method of Backend service
+func Method(name string) string {
+	return "/" + pb.Backend_ServiceDesc.ServiceName + "/" + name
+}
+
+func New(user userPkg.Interface, role rolePkg.Interface, roles *rolePkg.Registry, audit auditPkg.Interface, cache cachePkg.Interface, tokens *auth.TokenManager) *implementation {
 	return &implementation{
-		user:  user,
-		cache: redis,
+		user:   user,
+		role:   role,
+		roles:  roles,
+		audit:  audit,
+		cache:  cache,
+		loader: cachePkg.NewLoader(cache, config.CacheConfig.TTL, config.CacheConfig.NegativeTTL, config.CacheConfig.TTLJitter),
+		lists:  UserListGeneration(cache),
+		tokens: tokens,
 	}
 }
 
+// UserListGeneration returns the generation of cached lists of users, it is bumped by every change of users
+func UserListGeneration(cache cachePkg.Interface) *cachePkg.Generation {
+	return cachePkg.NewGeneration(cache, "UserListGeneration")
+}
+
 type implementation struct {
 	pb.UnimplementedBackendServer
-	user  userPkg.Interface
-	cache *redis.Client
+	user   userPkg.Interface
+	role   rolePkg.Interface
+	roles  *rolePkg.Registry
+	audit  auditPkg.Interface
+	cache  cachePkg.Interface
+	loader *cachePkg.Loader
+	lists  *cachePkg.Generation
+	tokens *auth.TokenManager
+}
+
+func (i implementation) Login(ctx context.Context, in *pb.BackendLoginRequest) (*pb.BackendLoginResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/Login")
+	defer span.End()
+	span.SetAttributes(attribute.String("user.email", in.GetEmail()))
+
+	user, err := i.user.GetByEmail(ctx, in.GetEmail())
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(codes.Unauthenticated, errMsgBadCredentials)
+	}
+
+	if err := auth.VerifyPassword(*user, in.GetPassword()); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.Unauthenticated, errMsgBadCredentials)
+	}
+	i.upgradePasswordHash(ctx, *user, in.GetPassword())
+
+	tokens, err := i.tokens.Issue(*user)
+	if err != nil {
+		tracing.Fail(span, err, "token error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BackendLoginResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    uint64(tokens.ExpiresIn.Seconds()),
+	}, nil
+}
+
+func (i implementation) Refresh(ctx context.Context, in *pb.BackendRefreshRequest) (*pb.BackendRefreshResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/Refresh")
+	defer span.End()
+
+	identity, err := i.tokens.ParseRefresh(ctx, in.GetRefreshToken())
+	if err != nil {
+		tracing.Fail(span, err, "invalid refresh token")
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	// user data could be changed after login, so new tokens are issued with the actual ones
+	user, err := i.user.Get(ctx, identity.Id, false)
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	// refresh token can be used only once, the token is revoked atomically, so that the check
+	// of ParseRefresh is not passed by concurrent requests with the same token
+	if err := i.tokens.Consume(ctx, in.GetRefreshToken()); err != nil {
+		if errors.Is(err, auth.ErrRevokedToken) {
+			tracing.Fail(span, err, "invalid refresh token")
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		tracing.Fail(span, err, "token error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tokens, err := i.tokens.Issue(*user)
+	if err != nil {
+		tracing.Fail(span, err, "token error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BackendRefreshResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    uint64(tokens.ExpiresIn.Seconds()),
+	}, nil
+}
+
+func (i implementation) Logout(ctx context.Context, in *pb.BackendLogoutRequest) (*pb.BackendLogoutResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/Logout")
+	defer span.End()
+
+	if err := i.tokens.Revoke(ctx, in.GetRefreshToken()); err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			tracing.Fail(span, err, "invalid refresh token")
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		tracing.Fail(span, err, "token error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BackendLogoutResponse{}, nil
 }
 
 func (i implementation) UserCreate(ctx context.Context, in *pb.BackendUserCreateRequest) (*pb.BackendUserCreateResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "backend/UserCreate")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "backend/UserCreate")
+	defer span.End()
 
 	if err := validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate([]string{
 		in.GetEmail(),
@@ -47,18 +189,29 @@ func (i implementation) UserCreate(ctx context.Context, in *pb.BackendUserCreate
 		in.GetRole(),
 		in.GetPassword(),
 	})); err != nil {
-		span.LogKV("error", "validation error")
+		tracing.Fail(span, err, "validation error")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	pwdHash, err := auth.GenHashPassword(in.GetPassword())
+	if err != nil {
+		tracing.Fail(span, err, "password hash error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	id, err := i.user.Create(ctx, models.User{
 		Email:    in.GetEmail(),
 		Name:     in.GetName(),
 		Role:     in.GetRole(),
-		Password: auth.GenHashPassword(in.GetPassword()),
+		Password: pwdHash,
 	})
 	if err != nil {
-		span.LogKV("error", "db error")
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	i.forgetCachedUsers(ctx, id)
+
+	if err := i.InvalidateCacheUserList(ctx); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -68,58 +221,94 @@ func (i implementation) UserCreate(ctx context.Context, in *pb.BackendUserCreate
 }
 
 func (i implementation) UserGet(ctx context.Context, in *pb.BackendUserGetRequest) (*pb.BackendUserGetResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "backend/UserGet")
-	defer span.Finish()
-
-	cacheKey := "UserGet:" + strconv.FormatUint(in.GetId(), 10)
-	var user *models.User
+	ctx, span := tracer.Start(ctx, "backend/UserGet")
+	defer span.End()
 
-	cacheResult, err := i.cache.Get(cacheKey).Result()
-	if err == redis.Nil {
-		user, err = i.user.Get(ctx, uint(in.GetId()))
+	// only active users are cached, so deleted ones are always read from storage
+	if in.GetIncludeDeleted() {
+		user, err := i.user.Get(ctx, uint(in.GetId()), true)
 		if err != nil {
-			span.LogKV("error", "db error")
-			return nil, status.Error(codes.Internal, err.Error())
+			tracing.Fail(span, err, "db error")
+			return nil, status.Error(userErrorCode(err), err.Error())
 		}
+		return userGetResponse(*user), nil
+	}
 
-		dataToCache, err := json.Marshal(user)
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+	// absence of the user is cached as well, so that lookups of nonexistent ids do not reach the storage
+	var user *models.User
+	err := i.loader.Load(ctx, "UserGet", userCacheKey(uint(in.GetId())), &user, func(ctx context.Context) (interface{}, error) {
+		user, err := i.user.Get(ctx, uint(in.GetId()), false)
+		if errors.Is(err, userStoragePkg.ErrUserNotExists) {
+			return nil, cachePkg.Absent(err)
 		}
+		return user, err
+	})
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(userErrorCode(err), err.Error())
+	}
 
-		if err := i.cache.Set(cacheKey, string(dataToCache), 24*time.Hour).Err(); err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-		counter.CacheMisInc()
-	} else if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	} else {
-		if err := json.Unmarshal([]byte(cacheResult), &user); err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-		counter.CacheHitInc()
+	return userGetResponse(*user), nil
+}
+
+func userCacheKey(id uint) string {
+	return "UserGet:" + strconv.FormatUint(uint64(id), 10)
+}
+
+func userListItem(user models.User) *pb.BackendUserListResponse_User {
+	return &pb.BackendUserListResponse_User{
+		Id:        uint64(user.Id),
+		Email:     user.Email,
+		Name:      user.Name,
+		Role:      user.Role,
+		DeletedAt: deletedAt(user),
+		Version:   user.Version,
+	}
+}
+
+func userFilter(in *pb.BackendUserListRequest_Filter) models.UserFilter {
+	return models.UserFilter{
+		Roles:         in.GetRoles(),
+		EmailContains: in.GetEmailContains(),
+		EmailPrefix:   in.GetEmailPrefix(),
+		NameContains:  in.GetNameContains(),
+		NamePrefix:    in.GetNamePrefix(),
+		IdFrom:        uint(in.GetIdFrom()),
+		IdTo:          uint(in.GetIdTo()),
+
+		IncludeDeleted: in.GetIncludeDeleted(),
 	}
+}
 
+func userGetResponse(user models.User) *pb.BackendUserGetResponse {
 	return &pb.BackendUserGetResponse{
-		Id:    uint64(user.Id),
-		Email: user.Email,
-		Name:  user.Name,
-		Role:  user.Role,
-	}, nil
+		Id:        uint64(user.Id),
+		Email:     user.Email,
+		Name:      user.Name,
+		Role:      user.Role,
+		DeletedAt: deletedAt(user),
+		Version:   user.Version,
+	}
 }
 
 func (i implementation) UserList(ctx context.Context, in *pb.BackendUserListRequest) (*pb.BackendUserListResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "backend/UserList")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "backend/UserList")
+	defer span.End()
 
 	if in.GetOrder().GetField() != "" {
 		err := validatorPkg.ValidateSortingField(in.GetOrder().GetField())
 		if err != nil {
-			span.LogKV("error", "validation error")
+			tracing.Fail(span, err, "validation error")
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 	}
 
+	filter := userFilter(in.GetFilter())
+	if err := validatorPkg.ValidateUserFilter(filter); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	ctxData, ok := metadata.FromIncomingContext(ctx)
 	if ok {
 		log.Println(ctxData.Get("custom"))
@@ -143,55 +332,111 @@ func (i implementation) UserList(ctx context.Context, in *pb.BackendUserListRequ
 	if pageNum == 0 {
 		pageNum = config.DefaultPageNum
 	}
-	cacheKey := "UserList:" + strconv.FormatUint(recPerPage, 10) +
-		":" + strconv.FormatUint(pageNum, 10) +
-		":" + in.GetOrder().GetField() +
-		":" + strconv.FormatBool(in.GetOrder().GetDescending())
-	var users []models.User
 
-	cacheResult, err := i.cache.Get(cacheKey).Result()
-	if err == redis.Nil {
-		users, err = i.user.List(ctx, recPerPage, pageNum, sortingOrder)
+	// keyset pagination is used if page token is passed, even the empty one
+	keyset := in.PageToken != nil
+	var after *models.Cursor
+	if in.GetPageToken() != "" {
+		cursor, err := pagetoken.Decode(in.GetPageToken())
 		if err != nil {
-			span.LogKV("error", "db error")
-			return nil, status.Error(codes.Internal, err.Error())
+			tracing.Fail(span, err, "validation error")
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-		dataToCache, err := json.Marshal(users)
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+		if cursor.SortingOrder != sortingOrder {
+			tracing.Fail(span, nil, "validation error")
+			return nil, status.Error(codes.InvalidArgument, "page token does not match sorting order")
 		}
+		after = cursor
+	}
 
-		if err := i.cache.Set(cacheKey, string(dataToCache), 24*time.Hour).Err(); err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+	generation, err := i.lists.Current(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	cacheKey := "UserList:" + generation + ":" + strconv.FormatUint(recPerPage, 10) +
+		":" + strconv.FormatUint(pageNum, 10) +
+		":" + in.GetOrder().GetField() +
+		":" + strconv.FormatBool(in.GetOrder().GetDescending()) +
+		":" + filter.String()
+	if keyset {
+		cacheKey = "UserList:" + generation + ":" + strconv.FormatUint(recPerPage, 10) +
+			":token=" + in.GetPageToken() +
+			":" + in.GetOrder().GetField() +
+			":" + strconv.FormatBool(in.GetOrder().GetDescending()) +
+			":" + filter.String()
+	}
+	var users []models.User
+	err = i.loader.Load(ctx, "UserList", cacheKey, &users, func(ctx context.Context) (interface{}, error) {
+		if keyset {
+			// one more user is requested to find out if there is the next page
+			return i.user.ListByCursor(ctx, recPerPage+1, sortingOrder, filter, after)
 		}
-		counter.CacheMisInc()
-	} else if err != nil {
+		return i.user.List(ctx, recPerPage, pageNum, sortingOrder, filter)
+	})
+	if err != nil {
+		tracing.Fail(span, err, "db error")
 		return nil, status.Error(codes.Internal, err.Error())
-	} else {
-		if err := json.Unmarshal([]byte(cacheResult), &users); err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	totalCount, err := i.userCount(ctx, generation, filter)
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	pageCount := (totalCount + recPerPage - 1) / recPerPage
+
+	var hasMore bool
+	if keyset {
+		hasMore = uint64(len(users)) > recPerPage
+		if hasMore {
+			users = users[:recPerPage]
 		}
-		counter.CacheHitInc()
+	} else {
+		hasMore = pageNum < pageCount
 	}
 
 	result := make([]*pb.BackendUserListResponse_User, 0, len(users))
 	for _, user := range users {
-		result = append(result, &pb.BackendUserListResponse_User{
-			Id:    uint64(user.Id),
-			Email: user.Email,
-			Name:  user.Name,
-			Role:  user.Role,
+		result = append(result, userListItem(user))
+	}
+
+	var nextPageToken string
+	if keyset && hasMore {
+		last := users[len(users)-1]
+		nextPageToken, err = pagetoken.Encode(models.Cursor{
+			SortingOrder: sortingOrder,
+			Value:        last.SortingValue(sortingOrder.Field),
+			Id:           last.Id,
 		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	return &pb.BackendUserListResponse{
-		Users: result,
+		Users:         result,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+		PageCount:     pageCount,
+		HasMore:       hasMore,
 	}, nil
 }
 
+// userCount returns number of users matching the filter. The count is cached in the generation
+// of the lists of users, so it is invalidated by the same changes.
+func (i implementation) userCount(ctx context.Context, generation string, filter models.UserFilter) (uint64, error) {
+	cacheKey := "UserList:" + generation + ":count:" + filter.String()
+
+	var count uint64
+	err := i.loader.Load(ctx, "UserCount", cacheKey, &count, func(ctx context.Context) (interface{}, error) {
+		return i.user.Count(ctx, filter)
+	})
+	return count, err
+}
+
 func (i implementation) UserUpdate(ctx context.Context, in *pb.BackendUserUpdateRequest) (*pb.BackendUserUpdateResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "backend/UserUpdate")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "backend/UserUpdate")
+	defer span.End()
 
 	if err := validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate([]string{
 		in.GetEmail(),
@@ -202,15 +447,31 @@ func (i implementation) UserUpdate(ctx context.Context, in *pb.BackendUserUpdate
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	user, err := i.user.Get(ctx, uint(in.GetId()))
+	user, err := i.user.Get(ctx, uint(in.GetId()), false)
 	if err != nil {
-		span.LogKV("error", "db error")
-		return nil, status.Error(codes.Internal, err.Error())
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(userErrorCode(err), err.Error())
+	}
+
+	// fails fast, the version is checked again by the storage at the moment of update
+	if in.Version != nil && in.GetVersion() != user.Version {
+		tracing.Fail(span, nil, "version mismatch")
+		return nil, status.Error(codes.Aborted, userStoragePkg.ErrVersionMismatch.Error())
+	}
+
+	if passwordRequired(ctx) {
+		if err = auth.VerifyPassword(*user, in.GetOldpassword()); err != nil {
+			tracing.Fail(span, err, "validation error")
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		// the legacy hash is upgraded even if the update fails, e.g. by version mismatch
+		i.upgradePasswordHash(ctx, *user, in.GetOldpassword())
 	}
 
-	if err = auth.VerifyPassword(*user, in.GetOldpassword()); err != nil {
-		span.LogKV("error", "validation error")
-		return nil, status.Error(codes.PermissionDenied, err.Error())
+	pwdHash, err := auth.GenHashPassword(in.GetPassword())
+	if err != nil {
+		tracing.Fail(span, err, "password hash error")
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	user = &models.User{
@@ -218,111 +479,394 @@ func (i implementation) UserUpdate(ctx context.Context, in *pb.BackendUserUpdate
 		Email:    in.GetEmail(),
 		Name:     in.GetName(),
 		Role:     in.GetRole(),
-		Password: auth.GenHashPassword(in.GetPassword()),
+		Password: pwdHash,
+		Version:  in.GetVersion(),
+	}
+
+	user.Version, err = i.user.Update(ctx, *user)
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(userErrorCode(err), err.Error())
 	}
 
-	if err := i.user.Update(ctx, *user); err != nil {
-		span.LogKV("error", "db error")
+	if err := i.updateCachedUser(ctx, *user); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	cacheKey := "UserGet:" + strconv.FormatUint(in.GetId(), 10)
-	if i.cache.Get(cacheKey).Err() == nil {
-		//Key exists in cache. Let's update cache with new data.
-		dataToCache, err := json.Marshal(user)
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+	if err := i.InvalidateCacheUserList(ctx); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BackendUserUpdateResponse{
+		Version: user.Version,
+	}, nil
+}
+
+func (i implementation) UserPatch(ctx context.Context, in *pb.BackendUserPatchRequest) (*pb.BackendUserPatchResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/UserPatch")
+	defer span.End()
+
+	if err := validatorPkg.ValidateUpdateMask(in.GetUpdateMask().GetPaths()); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// only the changed fields are validated
+	fields := patchedFields(in)
+	if err := validatorPkg.ValidateParameters(fields); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := i.user.Get(ctx, uint(in.GetId()), false)
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(userErrorCode(err), err.Error())
+	}
+
+	// fails fast, the version is checked again by the storage at the moment of update.
+	// If the caller has not passed the version, the read one is used, so that concurrent changes
+	// of the fields which are not patched are not overwritten by their old values.
+	if in.Version != nil && in.GetVersion() != user.Version {
+		tracing.Fail(span, nil, "version mismatch")
+		return nil, status.Error(codes.Aborted, userStoragePkg.ErrVersionMismatch.Error())
+	}
+
+	_, emailChanged := fields["email"]
+	_, passwordChanged := fields["password"]
+	if (emailChanged || passwordChanged) && passwordRequired(ctx) {
+		if err = auth.VerifyPassword(*user, in.GetOldpassword()); err != nil {
+			tracing.Fail(span, err, "validation error")
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		}
+		// the patched user is written with all fields, so the upgraded hash must not be overwritten by the read one
+		user.Password = i.upgradePasswordHash(ctx, *user, in.GetOldpassword())
+	}
 
-		if err := i.cache.Set(cacheKey, string(dataToCache), 24*time.Hour).Err(); err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+	for field, value := range fields {
+		switch field {
+		case "email":
+			user.Email = value
+		case "name":
+			user.Name = value
+		case "role":
+			user.Role = value
+		case "password":
+			if user.Password, err = auth.GenHashPassword(value); err != nil {
+				tracing.Fail(span, err, "password hash error")
+				return nil, status.Error(codes.Internal, err.Error())
+			}
 		}
+	}
 
+	user.Version, err = i.user.Update(ctx, *user)
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(userErrorCode(err), err.Error())
 	}
 
-	if err := i.InvalidateCacheUserList(); err != nil {
+	if err := i.updateCachedUser(ctx, *user); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.BackendUserUpdateResponse{}, nil
+	if err := i.InvalidateCacheUserList(ctx); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BackendUserPatchResponse{
+		Version: user.Version,
+	}, nil
+}
+
+// patchedFields returns new values of the fields listed in the update mask of the request
+func patchedFields(in *pb.BackendUserPatchRequest) map[string]string {
+	values := map[string]string{
+		"email":    in.GetEmail(),
+		"name":     in.GetName(),
+		"role":     in.GetRole(),
+		"password": in.GetPassword(),
+	}
+
+	result := make(map[string]string, len(in.GetUpdateMask().GetPaths()))
+	for _, path := range in.GetUpdateMask().GetPaths() {
+		result[path] = values[path]
+	}
+	return result
+}
+
+// updateCachedUser replaces the cached user with the updated one if the user is cached
+func (i implementation) updateCachedUser(ctx context.Context, user models.User) error {
+	cacheKey := userCacheKey(user.Id)
+	if _, err := i.cache.Get(ctx, cacheKey); err != nil {
+		return nil
+	}
+	return i.loader.Store(ctx, cacheKey, user)
+}
+
+// forgetCachedUsers deletes cached users, including cached absence of them, e.g. after creation or restore.
+// Failure of deletion does not break the request, the cached value expires anyway.
+func (i implementation) forgetCachedUsers(ctx context.Context, ids ...uint) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, userCacheKey(id))
+	}
+	if err := i.cache.Delete(ctx, keys...); err != nil {
+		loggerPkg.Logger.Log.Error(fmt.Sprintf("error during cache invalidation of users %v: [%v]", ids, err))
+	}
 }
 
 func (i implementation) UserDelete(ctx context.Context, in *pb.BackendUserDeleteRequest) (*pb.BackendUserDeleteResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "backend/UserDelete")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "backend/UserDelete")
+	defer span.End()
 
-	user, err := i.user.Get(ctx, uint(in.GetId()))
+	user, err := i.user.Get(ctx, uint(in.GetId()), false)
 	if err != nil {
-		span.LogKV("error", "db error")
-		return nil, status.Error(codes.Internal, err.Error())
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(userErrorCode(err), err.Error())
 	}
 
-	if err = auth.VerifyPassword(*user, in.GetPassword()); err != nil {
-		span.LogKV("error", "validation error")
-		return nil, status.Error(codes.PermissionDenied, err.Error())
+	if passwordRequired(ctx) {
+		if err = auth.VerifyPassword(*user, in.GetPassword()); err != nil {
+			tracing.Fail(span, err, "validation error")
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		// the deleted user may be restored later, so it keeps the upgraded hash
+		i.upgradePasswordHash(ctx, *user, in.GetPassword())
 	}
 
 	if err := i.user.Delete(ctx, uint(in.GetId())); err != nil {
-		span.LogKV("error", "db error")
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(userErrorCode(err), err.Error())
+	}
+
+	i.forgetCachedUsers(ctx, uint(in.GetId()))
+
+	if err := i.InvalidateCacheUserList(ctx); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	cacheKey := "UserGet:" + strconv.FormatUint(in.GetId(), 10)
-	if err := i.cache.Del(cacheKey).Err(); err != nil {
-		loggerPkg.Logger.Log.Error(fmt.Sprintf("error during key deletion from cache [%v]", err))
+	return &pb.BackendUserDeleteResponse{}, nil
+}
+
+func (i implementation) UserRestore(ctx context.Context, in *pb.BackendUserRestoreRequest) (*pb.BackendUserRestoreResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/UserRestore")
+	defer span.End()
+
+	if err := i.user.Restore(ctx, uint(in.GetId())); err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(userErrorCode(err), err.Error())
 	}
+	// the deleted user could be cached as absent
+	i.forgetCachedUsers(ctx, uint(in.GetId()))
 
-	if err := i.InvalidateCacheUserList(); err != nil {
+	if err := i.InvalidateCacheUserList(ctx); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.BackendUserDeleteResponse{}, nil
+	return &pb.BackendUserRestoreResponse{}, nil
+}
+
+func (i implementation) UserPurge(ctx context.Context, in *pb.BackendUserPurgeRequest) (*pb.BackendUserPurgeResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/UserPurge")
+	defer span.End()
+
+	// only soft deleted users can be purged, so an active user is not lost by mistake
+	if err := i.user.Purge(ctx, uint(in.GetId())); err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(userErrorCode(err), err.Error())
+	}
+
+	if err := i.InvalidateCacheUserList(ctx); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BackendUserPurgeResponse{}, nil
 }
 
 func (i implementation) UsersAdd(stream pb.Backend_UsersAddServer) error {
 	ctx := stream.Context()
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "backend/UsersAdd")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "backend/UsersAdd")
+	defer span.End()
+
+	// lists are invalidated once at the end, even if the stream breaks after some users are created
+	var created bool
+	defer func() {
+		if !created {
+			return
+		}
+		if err := i.InvalidateCacheUserList(ctx); err != nil {
+			loggerPkg.Logger.Log.Error(fmt.Sprintf("error during cache invalidation of user lists [%v]", err))
+		}
+	}()
 
-	for {
+	var atomic bool
+	var batch []models.User
+	for index := 0; ; index++ {
 		in, err := stream.Recv()
 		if err == io.EOF {
-			return nil
+			break
 		}
 		if err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
+		if index == 0 {
+			atomic = in.GetAtomic()
+			span.SetAttributes(attribute.Bool("users.atomic", atomic))
+		}
 
-		id, err := i.user.Create(ctx, models.User{
-			Email:    in.GetEmail(),
-			Name:     in.GetName(),
-			Role:     in.GetRole(),
-			Password: auth.GenHashPassword(in.GetPassword()),
-		})
+		user, err := userToAdd(in)
+		if err != nil {
+			tracing.Fail(span, err, "validation error")
+		}
+
+		// users of atomic batch are added together after the end of the stream
+		if atomic {
+			if err != nil {
+				return status.Error(status.Code(err), fmt.Sprintf("batch item [%d]: %s", index, status.Convert(err).Message()))
+			}
+			if len(batch) == config.MaxAtomicBatchSize {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("batch is too large, max size is %d", config.MaxAtomicBatchSize))
+			}
+			batch = append(batch, user)
+			continue
+		}
+
+		response := &pb.BackendUsersAddResponse{}
+		if err == nil {
+			var id uint
+			if id, err = i.user.Create(ctx, user); err != nil {
+				tracing.Fail(span, err, "db error")
+				err = status.Error(userErrorCode(err), err.Error())
+			} else {
+				created = true
+				i.forgetCachedUsers(ctx, id)
+			}
+			response.Id = uint64(id)
+		}
 		if err != nil {
-			span.LogKV("error", "db error")
+			response.Code = int32(status.Code(err))
+			response.Message = status.Convert(err).Message()
+		}
+
+		if err := stream.Send(response); err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
+	}
 
+	if !atomic || len(batch) == 0 {
+		return nil
+	}
+
+	ids, err := i.user.CreateBatch(ctx, batch)
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return status.Error(userErrorCode(err), err.Error())
+	}
+	created = true
+	i.forgetCachedUsers(ctx, ids...)
+
+	for _, id := range ids {
 		if err := stream.Send(&pb.BackendUsersAddResponse{
 			Id: uint64(id),
 		}); err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
 	}
+	return nil
 }
 
-func (i implementation) InvalidateCacheUserList() error {
-	cacheKeysPattern := "UserList:*"
-	cacheKeys, err := i.cache.Keys(cacheKeysPattern).Result()
-	if err != nil && err != redis.Nil {
+// userToAdd validates the user of UsersAdd stream and hashes its password
+func userToAdd(in *pb.BackendUsersAddRequest) (models.User, error) {
+	if err := validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate([]string{
+		in.GetEmail(),
+		in.GetName(),
+		in.GetRole(),
+		in.GetPassword(),
+	})); err != nil {
+		return models.User{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pwdHash, err := auth.GenHashPassword(in.GetPassword())
+	if err != nil {
+		return models.User{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return models.User{
+		Email:    in.GetEmail(),
+		Name:     in.GetName(),
+		Role:     in.GetRole(),
+		Password: pwdHash,
+	}, nil
+}
+
+// upgradePasswordHash replaces the stored password hash of the user if it was made by a legacy algorithm
+// or with outdated parameters. It must be called only after the password has been verified.
+// The version of the user is kept and no events are recorded, since the user is not changed for clients.
+// Failure of upgrade does not break the request, the hash will be upgraded next time.
+// The stored hash is returned, it is the upgraded one or the hash of the user if it has not been upgraded.
+func (i implementation) upgradePasswordHash(ctx context.Context, user models.User, pwd string) string {
+	if !auth.NeedsRehash(user.Password) {
+		return user.Password
+	}
+
+	pwdHash, err := auth.GenHashPassword(pwd)
+	if err != nil {
+		loggerPkg.Logger.Log.Error(fmt.Sprintf("error during password rehash [%v]", err))
+		return user.Password
+	}
+
+	if err := i.user.UpgradePasswordHash(ctx, user.Id, user.Password, pwdHash); err != nil {
+		loggerPkg.Logger.Log.Error(fmt.Sprintf("error during password hash upgrade of user [%v]: [%v]", user.Id, err))
+		return user.Password
+	}
+
+	// the cached user keeps the legacy hash
+	i.forgetCachedUsers(ctx, user.Id)
+	return pwdHash
+}
+
+// passwordRequired tells whether the caller must confirm the change of the user by the password of the user.
+// Admins manage any user without it. Other users can change only own record by Permissions, so they confirm
+// the change by own password. The caller without identity is not trusted and confirms the change as well.
+func passwordRequired(ctx context.Context) bool {
+	identity, ok := auth.IdentityFromContext(ctx)
+	return !ok || identity.Role != models.RoleAdminName
+}
+
+func userErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, userStoragePkg.ErrUserNotExists), errors.Is(err, cachePkg.ErrAbsent):
+		return codes.NotFound
+	case errors.Is(err, userStoragePkg.ErrUserExists):
+		return codes.AlreadyExists
+	case errors.Is(err, userStoragePkg.ErrVersionMismatch):
+		return codes.Aborted
+	case errors.Is(err, userStoragePkg.ErrRoleNotExists):
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
+
+// deletedAt returns time of user's deletion, nil means that the user is active
+func deletedAt(user models.User) *timestamppb.Timestamp {
+	if !user.IsDeleted() {
+		return nil
+	}
+	return timestamppb.New(*user.DeletedAt)
+}
+
+// InvalidateCacheUserList bumps the generation of cached lists, the lists of old generations expire by ttl
+func (i implementation) InvalidateCacheUserList(ctx context.Context) error {
+	if err := i.lists.Bump(ctx); err != nil {
 		return status.Error(codes.Internal, err.Error())
 	}
-	if len(cacheKeys) > 0 {
-		if err := i.cache.Del(cacheKeys...).Err(); err != nil {
-			loggerPkg.Logger.Log.Error(fmt.Sprintf("error during keys deletion from cache [%v]", err))
-		}
+	return nil
+}
+
+func (i implementation) invalidateCache(ctx context.Context, prefix string) error {
+	if err := i.cache.DeleteByPrefix(ctx, prefix); err != nil {
+		return status.Error(codes.Internal, err.Error())
 	}
 	return nil
 }
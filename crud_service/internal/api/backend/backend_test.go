@@ -1,27 +1,70 @@
 package backend
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.ozon.dev/vldem/homework1/internal/auth"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	userStoragePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage/postgres"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+func TestLogin(t *testing.T) {
+	t.Run("legacy hash is upgraded keeping version", func(t *testing.T) {
+		// arrange
+		f := userSetUp(t)
+		f.service.tokens = auth.NewTokenManager("secret", "test", time.Minute, time.Hour, nil)
+		// md5 hash of the password salted with config.Md5HashKey
+		legacyHash := "35c04b93cbd80179466d9bf3554e6c3f"
+		f.userRepo.EXPECT().
+			GetByEmail(gomock.Any(), f.data.Email).Return(&models.User{
+			Id:       f.data.Id,
+			Email:    f.data.Email,
+			Name:     f.data.Name,
+			Role:     f.data.Role,
+			Password: legacyHash,
+			Version:  3,
+		}, nil).Times(1)
+		// Update is not expected, so the version and events of the user are not changed
+		f.userRepo.EXPECT().
+			UpgradePasswordHash(gomock.Any(), f.data.Id, legacyHash, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, _, newHash string) error {
+				assert.NoError(t, auth.VerifyPassword(models.User{Password: newHash}, f.data.Password))
+				assert.False(t, auth.NeedsRehash(newHash))
+				return nil
+			}).Times(1)
+
+		// act
+		resp, err := f.service.Login(f.Ctx, &pb.BackendLoginRequest{
+			Email:    f.data.Email,
+			Password: f.data.Password,
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.GetAccessToken())
+	})
+}
+
 func TestUserCreate(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// arrange
 		f := userSetUp(t)
 		f.userRepo.EXPECT().
-			Create(f.Ctx, models.User{
-				Email:    f.data.Email,
-				Name:     f.data.Name,
-				Role:     f.data.Role,
-				Password: auth.GenHashPassword(f.data.Password),
-			}).Return(uint(1), nil).Times(1)
+			Create(gomock.Any(), matchUser(models.User{
+				Email: f.data.Email,
+				Name:  f.data.Name,
+				Role:  f.data.Role,
+			}, f.data.Password)).Return(uint(1), nil).Times(1)
 
 		// act
 		resp, err := f.service.UserCreate(f.Ctx, &pb.BackendUserCreateRequest{
@@ -57,12 +100,11 @@ func TestUserCreate(t *testing.T) {
 			// arrange
 			f := userSetUp(t)
 			f.userRepo.EXPECT().
-				Create(f.Ctx, models.User{
-					Email:    f.data.Email,
-					Name:     f.data.Name,
-					Role:     f.data.Role,
-					Password: auth.GenHashPassword(f.data.Password),
-				}).Return(uint(0), errors.New("db error")).Times(1)
+				Create(gomock.Any(), matchUser(models.User{
+					Email: f.data.Email,
+					Name:  f.data.Name,
+					Role:  f.data.Role,
+				}, f.data.Password)).Return(uint(0), errors.New("db error")).Times(1)
 			// act
 			_, err := f.service.UserCreate(f.Ctx, &pb.BackendUserCreateRequest{
 				Email:    f.data.Email,
@@ -83,7 +125,7 @@ func TestUserGet(t *testing.T) {
 		// arrange
 		f := userSetUp(t)
 		f.userRepo.EXPECT().
-			Get(f.Ctx, f.data.Id).Return(&models.User{
+			Get(gomock.Any(), f.data.Id, false).Return(&models.User{
 			Id:    f.data.Id,
 			Email: f.data.Email,
 			Name:  f.data.Name,
@@ -103,12 +145,38 @@ func TestUserGet(t *testing.T) {
 		assert.Equal(t, f.data.Role, resp.GetRole())
 	})
 
+	t.Run("cached", func(t *testing.T) {
+		// arrange
+		f := userSetUp(t)
+		f.userRepo.EXPECT().
+			Get(gomock.Any(), f.data.Id, false).Return(&models.User{
+			Id:    f.data.Id,
+			Email: f.data.Email,
+			Name:  f.data.Name,
+			Role:  f.data.Role,
+		}, nil).Times(1)
+		_, err := f.service.UserGet(f.Ctx, &pb.BackendUserGetRequest{
+			Id: uint64(f.data.Id),
+		})
+		require.NoError(t, err)
+
+		// act
+		resp, err := f.service.UserGet(f.Ctx, &pb.BackendUserGetRequest{
+			Id: uint64(f.data.Id),
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, uint64(f.data.Id), resp.GetId())
+		assert.Equal(t, f.data.Email, resp.GetEmail())
+	})
+
 	t.Run("error", func(t *testing.T) {
 		// arrange
 		f := userSetUp(t)
 
 		f.userRepo.EXPECT().
-			Get(f.Ctx, f.data.Id).Return(nil, errors.New("db error")).Times(1)
+			Get(gomock.Any(), f.data.Id, false).Return(nil, errors.New("db error")).Times(1)
 
 		// act
 		_, err := f.service.UserGet(f.Ctx, &pb.BackendUserGetRequest{
@@ -119,6 +187,26 @@ func TestUserGet(t *testing.T) {
 		require.EqualError(t, err, "rpc error: code = Internal desc = db error")
 	})
 
+	t.Run("not found", func(t *testing.T) {
+		// arrange
+		f := userSetUp(t)
+		// absence of the user is cached, so the storage is asked once
+		f.userRepo.EXPECT().
+			Get(gomock.Any(), f.data.Id, false).Return(nil, userStoragePkg.ErrUserNotExists).Times(1)
+		_, err := f.service.UserGet(f.Ctx, &pb.BackendUserGetRequest{
+			Id: uint64(f.data.Id),
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		// act
+		_, err = f.service.UserGet(f.Ctx, &pb.BackendUserGetRequest{
+			Id: uint64(f.data.Id),
+		})
+
+		// assert
+		require.EqualError(t, err, "rpc error: code = NotFound desc = user does not exists")
+	})
+
 }
 
 func TestUserList(t *testing.T) {
@@ -126,10 +214,12 @@ func TestUserList(t *testing.T) {
 		// arrange
 		f := userListSetUp(t)
 		f.userRepo.EXPECT().
-			List(f.Ctx, f.recPerPage, f.pageNum, models.SortingOrder{
+			List(gomock.Any(), f.recPerPage, f.pageNum, models.SortingOrder{
 				Field:      f.order.Field,
 				Descending: f.order.Descending,
-			}).Return(f.data, nil).Times(1)
+			}, models.UserFilter{}).Return(f.data, nil).Times(1)
+		f.userRepo.EXPECT().
+			Count(gomock.Any(), models.UserFilter{}).Return(uint64(len(f.data)), nil).Times(1)
 
 		// act
 		resp, err := f.service.UserList(f.Ctx, &pb.BackendUserListRequest{
@@ -146,6 +236,47 @@ func TestUserList(t *testing.T) {
 		assert.Equal(t, f.list, resp.GetUsers())
 	})
 
+	t.Run("invalidated by create", func(t *testing.T) {
+		// arrange
+		f := userListSetUp(t)
+		in := &pb.BackendUserListRequest{
+			RecPerPage: &f.recPerPage,
+			PageNum:    &f.pageNum,
+			Order: &pb.BackendUserListRequest_SortingOrder{
+				Field:      f.order.Field,
+				Descending: f.order.Descending,
+			},
+		}
+		// the list is read from storage before and after the creation
+		f.userRepo.EXPECT().
+			List(gomock.Any(), f.recPerPage, f.pageNum, models.SortingOrder{
+				Field:      f.order.Field,
+				Descending: f.order.Descending,
+			}, models.UserFilter{}).Return(f.data, nil).Times(2)
+		f.userRepo.EXPECT().
+			Count(gomock.Any(), models.UserFilter{}).Return(uint64(len(f.data)), nil).Times(2)
+		f.userRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).Return(uint(3), nil).Times(1)
+		_, err := f.service.UserList(f.Ctx, in)
+		require.NoError(t, err)
+		_, err = f.service.UserList(f.Ctx, in)
+		require.NoError(t, err)
+
+		// act
+		_, err = f.service.UserCreate(f.Ctx, &pb.BackendUserCreateRequest{
+			Email:    "test03@dummy.com",
+			Name:     "Test3 Tester3",
+			Role:     "User",
+			Password: "123456",
+		})
+		require.NoError(t, err)
+		resp, err := f.service.UserList(f.Ctx, in)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, f.list, resp.GetUsers())
+	})
+
 	t.Run("error", func(t *testing.T) {
 		t.Run("invalid argument", func(t *testing.T) {
 			// arrange
@@ -169,10 +300,10 @@ func TestUserList(t *testing.T) {
 			// arrange
 			f := userListSetUp(t)
 			f.userRepo.EXPECT().
-				List(f.Ctx, f.recPerPage, f.pageNum, models.SortingOrder{
+				List(gomock.Any(), f.recPerPage, f.pageNum, models.SortingOrder{
 					Field:      f.order.Field,
 					Descending: f.order.Descending,
-				}).Return(nil, errors.New("db error")).Times(1)
+				}, models.UserFilter{}).Return(nil, errors.New("db error")).Times(1)
 
 			// act
 			_, err := f.service.UserList(f.Ctx, &pb.BackendUserListRequest{
@@ -196,22 +327,21 @@ func TestUserUpdate(t *testing.T) {
 		// arrange
 		f := userSetUp(t)
 		f.userRepo.EXPECT().
-			Get(f.Ctx, f.data.Id).Return(&models.User{
+			Get(gomock.Any(), f.data.Id, false).Return(&models.User{
 			Id:       f.data.Id,
 			Email:    f.data.Email,
 			Name:     f.data.Name,
 			Role:     f.data.Role,
-			Password: auth.GenHashPassword(f.data.Password),
+			Password: f.pwdHash,
 		}, nil).Times(1)
 
 		f.userRepo.EXPECT().
-			Update(f.Ctx, models.User{
-				Id:       f.data.Id,
-				Email:    f.data.Email,
-				Name:     f.data.Name,
-				Role:     f.data.Role,
-				Password: auth.GenHashPassword(f.data.Password),
-			}).Return(nil).Times(1)
+			Update(gomock.Any(), matchUser(models.User{
+				Id:    f.data.Id,
+				Email: f.data.Email,
+				Name:  f.data.Name,
+				Role:  f.data.Role,
+			}, f.data.Password)).Return(uint64(2), nil).Times(1)
 
 		// act
 		resp, err := f.service.UserUpdate(f.Ctx, &pb.BackendUserUpdateRequest{
@@ -225,7 +355,41 @@ func TestUserUpdate(t *testing.T) {
 
 		// assert
 		require.NoError(t, err)
-		assert.Equal(t, &pb.BackendUserUpdateResponse{}, resp)
+		assert.Equal(t, &pb.BackendUserUpdateResponse{Version: 2}, resp)
+	})
+
+	t.Run("admin updates user without password", func(t *testing.T) {
+		// arrange
+		f := userSetUp(t)
+		ctx := auth.ContextWithIdentity(f.Ctx, &auth.Identity{Id: 2, Role: models.RoleAdminName})
+		f.userRepo.EXPECT().
+			Get(gomock.Any(), f.data.Id, false).Return(&models.User{
+			Id:       f.data.Id,
+			Email:    f.data.Email,
+			Name:     f.data.Name,
+			Role:     f.data.Role,
+			Password: f.pwdHash,
+		}, nil).Times(1)
+		f.userRepo.EXPECT().
+			Update(gomock.Any(), matchUser(models.User{
+				Id:    f.data.Id,
+				Email: f.data.Email,
+				Name:  f.data.Name,
+				Role:  f.data.Role,
+			}, "654321")).Return(uint64(2), nil).Times(1)
+
+		// act
+		resp, err := f.service.UserUpdate(ctx, &pb.BackendUserUpdateRequest{
+			Id:       uint64(f.data.Id),
+			Email:    f.data.Email,
+			Name:     f.data.Name,
+			Role:     f.data.Role,
+			Password: "654321",
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, &pb.BackendUserUpdateResponse{Version: 2}, resp)
 	})
 
 	t.Run("error", func(t *testing.T) {
@@ -249,12 +413,12 @@ func TestUserUpdate(t *testing.T) {
 			// arrange
 			f := userSetUp(t)
 			f.userRepo.EXPECT().
-				Get(f.Ctx, f.data.Id).Return(&models.User{
+				Get(gomock.Any(), f.data.Id, false).Return(&models.User{
 				Id:       f.data.Id,
 				Email:    f.data.Email,
 				Name:     f.data.Name,
 				Role:     f.data.Role,
-				Password: auth.GenHashPassword(f.data.Password),
+				Password: f.pwdHash,
 			}, nil).Times(1)
 
 			// act
@@ -271,25 +435,51 @@ func TestUserUpdate(t *testing.T) {
 			require.EqualError(t, err, "rpc error: code = PermissionDenied desc = wrong old password")
 		})
 
+		t.Run("user updates own record with wrong password", func(t *testing.T) {
+			// arrange
+			f := userSetUp(t)
+			ctx := auth.ContextWithIdentity(f.Ctx, &auth.Identity{Id: f.data.Id, Role: models.RoleUserName})
+			f.userRepo.EXPECT().
+				Get(gomock.Any(), f.data.Id, false).Return(&models.User{
+				Id:       f.data.Id,
+				Email:    f.data.Email,
+				Name:     f.data.Name,
+				Role:     models.RoleUserName,
+				Password: f.pwdHash,
+			}, nil).Times(1)
+
+			// act
+			_, err := f.service.UserUpdate(ctx, &pb.BackendUserUpdateRequest{
+				Id:          uint64(f.data.Id),
+				Email:       f.data.Email,
+				Name:        f.data.Name,
+				Role:        models.RoleUserName,
+				Password:    "654321",
+				Oldpassword: "6543221",
+			})
+
+			// assert
+			require.EqualError(t, err, "rpc error: code = PermissionDenied desc = wrong old password")
+		})
+
 		t.Run("internal error", func(t *testing.T) {
 			// arrange
 			f := userSetUp(t)
 			f.userRepo.EXPECT().
-				Get(f.Ctx, f.data.Id).Return(&models.User{
+				Get(gomock.Any(), f.data.Id, false).Return(&models.User{
 				Id:       f.data.Id,
 				Email:    f.data.Email,
 				Name:     f.data.Name,
 				Role:     f.data.Role,
-				Password: auth.GenHashPassword(f.data.Password),
+				Password: f.pwdHash,
 			}, nil).Times(1)
 			f.userRepo.EXPECT().
-				Update(f.Ctx, models.User{
-					Id:       f.data.Id,
-					Email:    f.data.Email,
-					Name:     f.data.Name,
-					Role:     f.data.Role,
-					Password: auth.GenHashPassword(f.data.Password),
-				}).Return(errors.New("db error")).Times(1)
+				Update(gomock.Any(), matchUser(models.User{
+					Id:    f.data.Id,
+					Email: f.data.Email,
+					Name:  f.data.Name,
+					Role:  f.data.Role,
+				}, f.data.Password)).Return(uint64(0), errors.New("db error")).Times(1)
 
 			// act
 			_, err := f.service.UserUpdate(f.Ctx, &pb.BackendUserUpdateRequest{
@@ -305,6 +495,26 @@ func TestUserUpdate(t *testing.T) {
 			require.EqualError(t, err, "rpc error: code = Internal desc = db error")
 		})
 
+		t.Run("deleted user", func(t *testing.T) {
+			// arrange
+			f := userSetUp(t)
+			f.userRepo.EXPECT().
+				Get(gomock.Any(), f.data.Id, false).Return(nil, userStoragePkg.ErrUserNotExists).Times(1)
+
+			// act
+			_, err := f.service.UserUpdate(f.Ctx, &pb.BackendUserUpdateRequest{
+				Id:          uint64(f.data.Id),
+				Email:       f.data.Email,
+				Name:        f.data.Name,
+				Role:        f.data.Role,
+				Password:    f.data.Password,
+				Oldpassword: f.data.Password,
+			})
+
+			// assert
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
 	})
 
 }
@@ -314,15 +524,15 @@ func TestUserDelete(t *testing.T) {
 		// arrange
 		f := userSetUp(t)
 		f.userRepo.EXPECT().
-			Get(f.Ctx, f.data.Id).Return(&models.User{
+			Get(gomock.Any(), f.data.Id, false).Return(&models.User{
 			Id:       f.data.Id,
 			Email:    f.data.Email,
 			Name:     f.data.Name,
 			Role:     f.data.Role,
-			Password: auth.GenHashPassword(f.data.Password),
+			Password: f.pwdHash,
 		}, nil).Times(1)
 		f.userRepo.EXPECT().
-			Delete(f.Ctx, f.data.Id).Return(nil).Times(1)
+			Delete(gomock.Any(), f.data.Id).Return(nil).Times(1)
 
 		// act
 		resp, err := f.service.UserDelete(f.Ctx, &pb.BackendUserDeleteRequest{
@@ -335,17 +545,68 @@ func TestUserDelete(t *testing.T) {
 		assert.Equal(t, &pb.BackendUserDeleteResponse{}, resp)
 	})
 
+	t.Run("legacy hash is upgraded", func(t *testing.T) {
+		// arrange
+		f := userSetUp(t)
+		// md5 hash of the password salted with config.Md5HashKey
+		legacyHash := "35c04b93cbd80179466d9bf3554e6c3f"
+		f.userRepo.EXPECT().
+			Get(gomock.Any(), f.data.Id, false).Return(&models.User{
+			Id:       f.data.Id,
+			Email:    f.data.Email,
+			Password: legacyHash,
+		}, nil).Times(1)
+		f.userRepo.EXPECT().
+			UpgradePasswordHash(gomock.Any(), f.data.Id, legacyHash, gomock.Any()).Return(nil).Times(1)
+		f.userRepo.EXPECT().
+			Delete(gomock.Any(), f.data.Id).Return(nil).Times(1)
+
+		// act
+		_, err := f.service.UserDelete(f.Ctx, &pb.BackendUserDeleteRequest{
+			Id:       uint64(f.data.Id),
+			Password: f.data.Password,
+		})
+
+		// assert
+		require.NoError(t, err)
+	})
+
+	t.Run("admin deletes user without password", func(t *testing.T) {
+		// arrange
+		f := userSetUp(t)
+		ctx := auth.ContextWithIdentity(f.Ctx, &auth.Identity{Id: 2, Role: models.RoleAdminName})
+		f.userRepo.EXPECT().
+			Get(gomock.Any(), f.data.Id, false).Return(&models.User{
+			Id:       f.data.Id,
+			Email:    f.data.Email,
+			Name:     f.data.Name,
+			Role:     f.data.Role,
+			Password: f.pwdHash,
+		}, nil).Times(1)
+		f.userRepo.EXPECT().
+			Delete(gomock.Any(), f.data.Id).Return(nil).Times(1)
+
+		// act
+		resp, err := f.service.UserDelete(ctx, &pb.BackendUserDeleteRequest{
+			Id: uint64(f.data.Id),
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, &pb.BackendUserDeleteResponse{}, resp)
+	})
+
 	t.Run("error", func(t *testing.T) {
 		t.Run("permission denied", func(t *testing.T) {
 			// arrange
 			f := userSetUp(t)
 			f.userRepo.EXPECT().
-				Get(f.Ctx, f.data.Id).Return(&models.User{
+				Get(gomock.Any(), f.data.Id, false).Return(&models.User{
 				Id:       f.data.Id,
 				Email:    f.data.Email,
 				Name:     f.data.Name,
 				Role:     f.data.Role,
-				Password: auth.GenHashPassword(f.data.Password),
+				Password: f.pwdHash,
 			}, nil).Times(1)
 
 			// act
@@ -358,11 +619,34 @@ func TestUserDelete(t *testing.T) {
 			require.EqualError(t, err, "rpc error: code = PermissionDenied desc = wrong old password")
 		})
 
+		t.Run("user deletes own record with wrong password", func(t *testing.T) {
+			// arrange
+			f := userSetUp(t)
+			ctx := auth.ContextWithIdentity(f.Ctx, &auth.Identity{Id: f.data.Id, Role: models.RoleUserName})
+			f.userRepo.EXPECT().
+				Get(gomock.Any(), f.data.Id, false).Return(&models.User{
+				Id:       f.data.Id,
+				Email:    f.data.Email,
+				Name:     f.data.Name,
+				Role:     models.RoleUserName,
+				Password: f.pwdHash,
+			}, nil).Times(1)
+
+			// act
+			_, err := f.service.UserDelete(ctx, &pb.BackendUserDeleteRequest{
+				Id:       uint64(f.data.Id),
+				Password: "654321",
+			})
+
+			// assert
+			require.EqualError(t, err, "rpc error: code = PermissionDenied desc = wrong old password")
+		})
+
 		t.Run("user not found", func(t *testing.T) {
 			// arrange
 			f := userSetUp(t)
 			f.userRepo.EXPECT().
-				Get(f.Ctx, uint(2)).Return(nil, errors.New("user not found")).Times(1)
+				Get(gomock.Any(), uint(2), false).Return(nil, errors.New("user not found")).Times(1)
 
 			// act
 			_, err := f.service.UserDelete(f.Ctx, &pb.BackendUserDeleteRequest{
@@ -374,6 +658,91 @@ func TestUserDelete(t *testing.T) {
 			require.EqualError(t, err, "rpc error: code = Internal desc = user not found")
 		})
 
+		t.Run("deleted user", func(t *testing.T) {
+			// arrange
+			f := userSetUp(t)
+			f.userRepo.EXPECT().
+				Get(gomock.Any(), f.data.Id, false).Return(nil, userStoragePkg.ErrUserNotExists).Times(1)
+
+			// act
+			_, err := f.service.UserDelete(f.Ctx, &pb.BackendUserDeleteRequest{
+				Id:       uint64(f.data.Id),
+				Password: f.data.Password,
+			})
+
+			// assert
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
+		t.Run("deleted concurrently", func(t *testing.T) {
+			// arrange
+			f := userSetUp(t)
+			f.userRepo.EXPECT().
+				Get(gomock.Any(), f.data.Id, false).Return(&models.User{
+				Id:       f.data.Id,
+				Email:    f.data.Email,
+				Name:     f.data.Name,
+				Role:     f.data.Role,
+				Password: f.pwdHash,
+			}, nil).Times(1)
+			f.userRepo.EXPECT().
+				Delete(gomock.Any(), f.data.Id).Return(userStoragePkg.ErrUserNotExists).Times(1)
+
+			// act
+			_, err := f.service.UserDelete(f.Ctx, &pb.BackendUserDeleteRequest{
+				Id:       uint64(f.data.Id),
+				Password: f.data.Password,
+			})
+
+			// assert
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
 	})
 
 }
+
+func TestUserPatch(t *testing.T) {
+	t.Run("legacy hash is upgraded and kept by the patch", func(t *testing.T) {
+		// arrange
+		f := userSetUp(t)
+		// md5 hash of the password salted with config.Md5HashKey
+		legacyHash := "35c04b93cbd80179466d9bf3554e6c3f"
+		f.userRepo.EXPECT().
+			Get(gomock.Any(), f.data.Id, false).Return(&models.User{
+			Id:       f.data.Id,
+			Email:    f.data.Email,
+			Name:     f.data.Name,
+			Role:     f.data.Role,
+			Password: legacyHash,
+			Version:  3,
+		}, nil).Times(1)
+		var upgradedHash string
+		f.userRepo.EXPECT().
+			UpgradePasswordHash(gomock.Any(), f.data.Id, legacyHash, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, _, newHash string) error {
+				upgradedHash = newHash
+				return nil
+			}).Times(1)
+		f.userRepo.EXPECT().
+			Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, user models.User) (uint64, error) {
+				assert.Equal(t, "test02@dummy.com", user.Email)
+				assert.Equal(t, upgradedHash, user.Password)
+				assert.Equal(t, uint64(3), user.Version)
+				return 4, nil
+			}).Times(1)
+
+		// act
+		resp, err := f.service.UserPatch(f.Ctx, &pb.BackendUserPatchRequest{
+			Id:          uint64(f.data.Id),
+			Email:       "test02@dummy.com",
+			Oldpassword: f.data.Password,
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"email"}},
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, uint64(4), resp.GetVersion())
+	})
+}
@@ -0,0 +1,150 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	roleStoragePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role/storage"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errMsgBuiltinRole = "built-in role cannot be changed"
+
+func (i implementation) RoleCreate(ctx context.Context, in *pb.BackendRoleCreateRequest) (*pb.BackendRoleCreateResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/RoleCreate")
+	defer span.End()
+
+	if err := validatorPkg.ValidateRoleName(in.GetName()); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := i.role.Create(ctx, models.Role{
+		Name: in.GetName(),
+	})
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(roleErrorCode(err), err.Error())
+	}
+	i.refreshRoles(ctx)
+
+	return &pb.BackendRoleCreateResponse{
+		Id: uint64(id),
+	}, nil
+}
+
+func (i implementation) RoleList(ctx context.Context, in *pb.BackendRoleListRequest) (*pb.BackendRoleListResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/RoleList")
+	defer span.End()
+
+	roles, err := i.role.List(ctx)
+	if err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*pb.BackendRoleListResponse_Role, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, &pb.BackendRoleListResponse_Role{
+			Id:   uint64(role.Id),
+			Name: role.Name,
+		})
+	}
+
+	return &pb.BackendRoleListResponse{
+		Roles: result,
+	}, nil
+}
+
+func (i implementation) RoleUpdate(ctx context.Context, in *pb.BackendRoleUpdateRequest) (*pb.BackendRoleUpdateResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/RoleUpdate")
+	defer span.End()
+
+	if err := validatorPkg.ValidateRoleId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validatorPkg.ValidateRoleName(in.GetName()); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	role := models.Role{
+		Id:   uint8(in.GetId()),
+		Name: in.GetName(),
+	}
+	if role.IsBuiltin() {
+		tracing.Fail(span, nil, "built-in role")
+		return nil, status.Error(codes.FailedPrecondition, errMsgBuiltinRole)
+	}
+
+	if err := i.role.Update(ctx, role); err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(roleErrorCode(err), err.Error())
+	}
+	i.refreshRoles(ctx)
+
+	// cached users contain the old name of the role
+	if err := i.invalidateCache(ctx, "UserGet:"); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := i.InvalidateCacheUserList(ctx); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.BackendRoleUpdateResponse{}, nil
+}
+
+func (i implementation) RoleDelete(ctx context.Context, in *pb.BackendRoleDeleteRequest) (*pb.BackendRoleDeleteResponse, error) {
+	ctx, span := tracer.Start(ctx, "backend/RoleDelete")
+	defer span.End()
+
+	if err := validatorPkg.ValidateRoleId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	role := models.Role{
+		Id: uint8(in.GetId()),
+	}
+	if role.IsBuiltin() {
+		tracing.Fail(span, nil, "built-in role")
+		return nil, status.Error(codes.FailedPrecondition, errMsgBuiltinRole)
+	}
+
+	if err := i.role.Delete(ctx, role.Id); err != nil {
+		tracing.Fail(span, err, "db error")
+		return nil, status.Error(roleErrorCode(err), err.Error())
+	}
+	i.refreshRoles(ctx)
+
+	return &pb.BackendRoleDeleteResponse{}, nil
+}
+
+// refreshRoles updates the registry used by validation right after roles have been changed.
+// On failure the registry is updated by the next periodic refresh.
+func (i implementation) refreshRoles(ctx context.Context) {
+	if err := i.roles.Refresh(ctx); err != nil {
+		loggerPkg.Logger.Log.Error(fmt.Sprintf("error during roles refresh [%v]", err))
+	}
+}
+
+func roleErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, roleStoragePkg.ErrRoleNotExists):
+		return codes.NotFound
+	case errors.Is(err, roleStoragePkg.ErrRoleExists):
+		return codes.AlreadyExists
+	case errors.Is(err, roleStoragePkg.ErrRoleInUse):
+		return codes.FailedPrecondition
+	}
+	return codes.Internal
+}
@@ -2,19 +2,46 @@ package backend
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	cachePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/cache"
+	rolePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role"
 	mock_repository "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/mocks"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.uber.org/zap"
 )
 
+// TestMain loads the built-in roles into the registry of the validator
+func TestMain(m *testing.M) {
+	roles := rolePkg.NewRegistry(rolePkg.SourceFunc(func(context.Context) ([]models.Role, error) {
+		return []models.Role{
+			{Id: 1, Name: models.RoleAdminName},
+			{Id: 2, Name: models.RoleUserName},
+		}, nil
+	}))
+	if err := roles.Refresh(context.Background()); err != nil {
+		panic(err)
+	}
+	validatorPkg.SetRoleRegistry(roles)
+	loggerPkg.Logger.Log = zap.NewNop()
+
+	os.Exit(m.Run())
+}
+
 type backendFixture struct {
 	Ctx      context.Context
 	userRepo *mock_repository.MockInterface
 	service  *implementation
 	data     models.User
+	pwdHash  string
 	list     []models.User
 }
 
@@ -34,7 +61,7 @@ func userSetUp(t *testing.T) backendFixture {
 
 	f := backendFixture{Ctx: context.Background()}
 	f.userRepo = mock_repository.NewMockInterface(gomock.NewController(t))
-	f.service = New(f.userRepo)
+	f.service = New(f.userRepo, nil, nil, nil, cachePkg.NewLRU(0), nil)
 	f.data = models.User{
 		Id:       1,
 		Email:    "test01@dummy.com",
@@ -42,6 +69,10 @@ func userSetUp(t *testing.T) backendFixture {
 		Role:     "Admin",
 		Password: "123456",
 	}
+
+	var err error
+	f.pwdHash, err = auth.GenHashPassword(f.data.Password)
+	require.NoError(t, err)
 	return f
 }
 
@@ -54,7 +85,7 @@ func userListSetUp(t *testing.T) userListFixture {
 		list: []*pb.BackendUserListResponse_User{},
 	}
 	f.userRepo = mock_repository.NewMockInterface(gomock.NewController(t))
-	f.service = New(f.userRepo)
+	f.service = New(f.userRepo, nil, nil, nil, cachePkg.NewLRU(0), nil)
 	f.recPerPage = 10
 	f.pageNum = 1
 	f.order = models.SortingOrder{
@@ -87,3 +118,33 @@ func userListSetUp(t *testing.T) userListFixture {
 	})
 	return f
 }
+
+// userMatcher matches user ignoring the password hash which is randomly salted.
+// Instead it checks that the hash corresponds to the plain password.
+type userMatcher struct {
+	user     models.User
+	password string
+}
+
+func matchUser(user models.User, password string) gomock.Matcher {
+	return userMatcher{
+		user:     user,
+		password: password,
+	}
+}
+
+func (m userMatcher) Matches(x interface{}) bool {
+	user, ok := x.(models.User)
+	if !ok {
+		return false
+	}
+	if err := auth.VerifyPassword(user, m.password); err != nil {
+		return false
+	}
+	user.Password = m.user.Password
+	return user == m.user
+}
+
+func (m userMatcher) String() string {
+	return fmt.Sprintf("is equal to %v with password hash of <%v>", m.user, m.password)
+}
@@ -0,0 +1,205 @@
+package backend
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	userStoragePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage/postgres"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UsersExport streams users matching the filter page by page. Keyset pagination is used,
+// so users added or deleted during export are not repeated or skipped.
+func (i implementation) UsersExport(in *pb.BackendUsersExportRequest, stream pb.Backend_UsersExportServer) error {
+	ctx, span := tracer.Start(stream.Context(), "backend/UsersExport")
+	defer span.End()
+
+	filter := userFilter(in.GetFilter())
+	if err := validatorPkg.ValidateUserFilter(filter); err != nil {
+		tracing.Fail(span, err, "validation error")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sortingOrder := models.SortingOrder{Field: "id"}
+	var after *models.Cursor
+	for {
+		users, err := i.user.ListByCursor(ctx, config.ExportPageSize, sortingOrder, filter, after)
+		if err != nil {
+			tracing.Fail(span, err, "db error")
+			return status.Error(codes.Internal, err.Error())
+		}
+		if len(users) == 0 {
+			return nil
+		}
+
+		result := make([]*pb.BackendUserListResponse_User, 0, len(users))
+		for _, user := range users {
+			result = append(result, userListItem(user))
+		}
+		if err := stream.Send(&pb.BackendUsersExportResponse{
+			Users: result,
+		}); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if len(users) < config.ExportPageSize {
+			return nil
+		}
+		after = &models.Cursor{
+			SortingOrder: sortingOrder,
+			Id:           users[len(users)-1].Id,
+		}
+	}
+}
+
+type importResult int
+
+const (
+	importCreated importResult = iota
+	importUpdated
+	importSkipped
+)
+
+// UsersImport adds users of the stream one by one. Users which cannot be imported are reported
+// with the line of the file, so that only they can be fixed and imported again.
+func (i implementation) UsersImport(stream pb.Backend_UsersImportServer) error {
+	ctx, span := tracer.Start(stream.Context(), "backend/UsersImport")
+	defer span.End()
+
+	var options *pb.BackendUsersImportRequest_Options
+	report := &pb.BackendUsersImportResponse{}
+	// emails of the previous users of the stream, they are duplicates even if they are not added in dry run
+	imported := map[string]struct{}{}
+	for index := 0; ; index++ {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if index == 0 {
+			options = in.GetOptions()
+			report.DryRun = options.GetDryRun()
+			span.SetAttributes(
+				attribute.Bool("import.dry_run", options.GetDryRun()),
+				attribute.String("import.on_duplicate", options.GetOnDuplicate().String()),
+			)
+		}
+		if in.GetUser() == nil {
+			continue
+		}
+
+		report.Total++
+		result, err := i.importUser(ctx, in.GetUser(), options, imported)
+		if err != nil {
+			tracing.Fail(span, err, "import error")
+			report.Failed++
+			report.Errors = append(report.Errors, &pb.BackendUsersImportResponse_Error{
+				Line:    in.GetUser().GetLine(),
+				Email:   in.GetUser().GetEmail(),
+				Code:    int32(status.Code(err)),
+				Message: status.Convert(err).Message(),
+			})
+			continue
+		}
+
+		switch result {
+		case importCreated:
+			report.Created++
+		case importUpdated:
+			report.Updated++
+		case importSkipped:
+			report.Skipped++
+		}
+	}
+
+	if !report.GetDryRun() && report.GetCreated()+report.GetUpdated() > 0 {
+		if err := i.InvalidateCacheUserList(ctx); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return stream.SendAndClose(report)
+}
+
+func (i implementation) importUser(ctx context.Context, in *pb.BackendUsersImportRequest_User, options *pb.BackendUsersImportRequest_Options, imported map[string]struct{}) (importResult, error) {
+	fields := map[string]string{
+		"email": in.GetEmail(),
+		"name":  in.GetName(),
+		"role":  in.GetRole(),
+	}
+	if in.GetPassword() != "" {
+		fields["password"] = in.GetPassword()
+	}
+	if err := validatorPkg.ValidateParameters(fields); err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	existing, err := i.user.GetByEmail(ctx, in.GetEmail())
+	if err != nil && !errors.Is(err, userStoragePkg.ErrUserNotExists) {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+	_, duplicate := imported[in.GetEmail()]
+
+	if existing == nil && !duplicate {
+		if in.GetPassword() == "" {
+			return 0, status.Error(codes.InvalidArgument, "password is required for new user")
+		}
+		if !options.GetDryRun() {
+			pwdHash, err := auth.GenHashPassword(in.GetPassword())
+			if err != nil {
+				return 0, status.Error(codes.Internal, err.Error())
+			}
+			id, err := i.user.Create(ctx, models.User{
+				Email:    in.GetEmail(),
+				Name:     in.GetName(),
+				Role:     in.GetRole(),
+				Password: pwdHash,
+			})
+			if err != nil {
+				return 0, status.Error(userErrorCode(err), err.Error())
+			}
+			i.forgetCachedUsers(ctx, id)
+		}
+		imported[in.GetEmail()] = struct{}{}
+		return importCreated, nil
+	}
+
+	switch options.GetOnDuplicate() {
+	case pb.BackendUsersImportRequest_SKIP:
+		return importSkipped, nil
+	case pb.BackendUsersImportRequest_UPDATE:
+		// in dry run the user of a previous line is not added, so there is nothing to update
+		if options.GetDryRun() || existing == nil {
+			return importUpdated, nil
+		}
+
+		existing.Name = in.GetName()
+		existing.Role = in.GetRole()
+		if in.GetPassword() != "" {
+			if existing.Password, err = auth.GenHashPassword(in.GetPassword()); err != nil {
+				return 0, status.Error(codes.Internal, err.Error())
+			}
+		}
+		// the read version protects from overwriting of concurrent changes
+		if existing.Version, err = i.user.Update(ctx, *existing); err != nil {
+			return 0, status.Error(userErrorCode(err), err.Error())
+		}
+		if err := i.updateCachedUser(ctx, *existing); err != nil {
+			return 0, status.Error(codes.Internal, err.Error())
+		}
+		return importUpdated, nil
+	}
+
+	return 0, status.Error(codes.AlreadyExists, userStoragePkg.ErrUserExists.Error())
+}
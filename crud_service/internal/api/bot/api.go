@@ -2,51 +2,157 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log"
 	"strconv"
 
-	"github.com/opentracing/opentracing-go"
-	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	rolePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/counter"
 	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
-func New(client pb.BackendClient) pb.AdminServer {
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/api/bot")
+
+// Permissions define who is allowed to call methods of Admin service
+var Permissions = auth.Permissions{
+	method("Login"):       {Public: true},
+	method("Refresh"):     {Public: true},
+	method("Logout"):      {Public: true},
+	method("UserCreate"):  {Roles: []string{models.RoleAdminName}},
+	method("UsersAdd"):    {Roles: []string{models.RoleAdminName}},
+	method("UserList"):    {Roles: []string{models.RoleAdminName}},
+	method("UserDelete"):  {Roles: []string{models.RoleAdminName}},
+	method("UserGet"):     {Roles: []string{models.RoleAdminName}, Self: true},
+	method("UserUpdate"):  {Roles: []string{models.RoleAdminName}, Self: true},
+	method("UserPatch"):   {Roles: []string{models.RoleAdminName}, Self: true},
+	method("UserRestore"): {Roles: []string{models.RoleAdminName}},
+	method("UserPurge"):   {Roles: []string{models.RoleAdminName}},
+	method("RoleCreate"):  {Roles: []string{models.RoleAdminName}},
+	method("RoleUpdate"):  {Roles: []string{models.RoleAdminName}},
+	method("RoleDelete"):  {Roles: []string{models.RoleAdminName}},
+	method("RoleList"):    {Roles: []string{models.RoleAdminName}},
+	method("AuditList"):   {Roles: []string{models.RoleAdminName}},
+	method("UsersExport"): {Roles: []string{models.RoleAdminName}},
+	method("UsersImport"): {Roles: []string{models.RoleAdminName}},
+}
+
+func method(name string) string {
+	return "/" + pb.Admin_ServiceDesc.ServiceName + "/" + name
+}
+
+func New(client pb.BackendClient, roles *rolePkg.Registry) pb.AdminServer {
 	return &implementation{
 		client: client,
+		roles:  roles,
 	}
 }
 
 type implementation struct {
 	client pb.BackendClient
+	roles  *rolePkg.Registry
 	pb.UnimplementedAdminServer
 }
 
+func (i implementation) Login(ctx context.Context, in *pb.LoginRequest) (*pb.LoginResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/Login")
+	defer span.End()
+	span.SetAttributes(attribute.String("user.email", in.GetEmail()))
+
+	counter.InRequestInc()
+	if err := validatorPkg.ValidateEmail(in.GetEmail()); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	counter.OutRequestInc()
+	out, err := i.client.Login(ctx, &pb.BackendLoginRequest{
+		Email:    in.GetEmail(),
+		Password: in.GetPassword(),
+	})
+	if err != nil {
+		counter.FailedRequestInc()
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+
+	return &pb.LoginResponse{
+		AccessToken:  out.GetAccessToken(),
+		RefreshToken: out.GetRefreshToken(),
+		ExpiresIn:    out.GetExpiresIn(),
+	}, nil
+}
+
+func (i implementation) Refresh(ctx context.Context, in *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/Refresh")
+	defer span.End()
+
+	counter.InRequestInc()
+	counter.OutRequestInc()
+	out, err := i.client.Refresh(ctx, &pb.BackendRefreshRequest{
+		RefreshToken: in.GetRefreshToken(),
+	})
+	if err != nil {
+		counter.FailedRequestInc()
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+
+	return &pb.RefreshResponse{
+		AccessToken:  out.GetAccessToken(),
+		RefreshToken: out.GetRefreshToken(),
+		ExpiresIn:    out.GetExpiresIn(),
+	}, nil
+}
+
+func (i implementation) Logout(ctx context.Context, in *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/Logout")
+	defer span.End()
+
+	counter.InRequestInc()
+	counter.OutRequestInc()
+	if _, err := i.client.Logout(ctx, &pb.BackendLogoutRequest{
+		RefreshToken: in.GetRefreshToken(),
+	}); err != nil {
+		counter.FailedRequestInc()
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+
+	return &pb.LogoutResponse{}, nil
+}
+
 func (i implementation) UserCreate(ctx context.Context, in *pb.UserCreateRequest) (*pb.UserCreateResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "ui/UserCreate")
-	defer span.Finish()
-	span.LogKV("user_email", in.GetEmail())
-	span.LogKV("user_name", in.GetName())
-	span.LogKV("user_role", in.GetRole())
-	span.LogKV("user_password", in.GetPassword())
+	ctx, span := tracer.Start(ctx, "ui/UserCreate")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("user.email", in.GetEmail()),
+		attribute.String("user.name", in.GetName()),
+		attribute.String("user.role", in.GetRole()),
+	)
 
 	counter.InRequestInc()
-	if err := validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate([]string{
-		in.GetEmail(),
-		in.GetName(),
-		in.GetRole(),
-		in.GetPassword(),
-	})); err != nil {
+	if err := ValidateUserCreate(in); err != nil {
 		counter.ErrorCounterInc()
-		span.LogKV("error", "validation error")
+		tracing.Fail(span, err, "validation error")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -60,8 +166,8 @@ func (i implementation) UserCreate(ctx context.Context, in *pb.UserCreateRequest
 	if err != nil {
 		counter.FailedRequestInc()
 		counter.ErrorCounterInc()
-		span.LogKV("error", "error from backend service")
-		return nil, status.Error(codes.Internal, err.Error())
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
 	}
 	counter.SuccessRequestInc()
 
@@ -71,44 +177,89 @@ func (i implementation) UserCreate(ctx context.Context, in *pb.UserCreateRequest
 }
 
 func (i implementation) UsersAdd(ctx context.Context, in *pb.UsersAddRequest) (*pb.UsersAddResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "ui/UsersAdd")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "ui/UsersAdd")
+	defer span.End()
+	span.SetAttributes(attribute.Bool("users.atomic", in.GetAtomic()))
 
 	counter.InRequestInc()
 	data := in.Users
 	if len(data) == 0 {
-		span.LogKV("error", "invalid argument: data is empty")
+		tracing.Fail(span, nil, "invalid argument: data is empty")
 		return nil, status.Error(codes.InvalidArgument, "data is empty")
 	}
+
+	// invalid users are not sent to the backend, in non-atomic mode they only get failed results
+	results := make([]*pb.UsersAddResponse_Result, len(data))
+	sent := make([]int, 0, len(data))
 	for i := 0; i < len(data); i++ {
-		if err := validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate([]string{
-			data[i].Email,
-			data[i].Name,
-			data[i].Role,
-			data[i].Password,
-		})); err != nil {
+		if err := ValidateUsersAddUser(data[i]); err != nil {
 			counter.ErrorCounterInc()
-			span.LogKV("error", "validation error")
+			tracing.Fail(span, err, "validation error")
 			loggerPkg.Logger.Log.Debug("Validation filed",
 				zap.Int("iteration:", i),
 				zap.String("email:", data[i].Email),
 				zap.String("name:", data[i].Name),
 				zap.String("role:", data[i].Role),
-				zap.String("password:", data[i].Password),
 			)
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+			if in.GetAtomic() {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("batch item [%d]: %s", i, err.Error()))
+			}
+			results[i] = &pb.UsersAddResponse_Result{
+				Code:    int32(codes.InvalidArgument),
+				Message: err.Error(),
+			}
+			continue
+		}
+		sent = append(sent, i)
+	}
+
+	if len(sent) > 0 {
+		received, err := i.usersAdd(ctx, in.GetAtomic(), data, sent)
+		if err != nil {
+			return nil, err
+		}
+		if len(received) != len(sent) {
+			counter.ErrorCounterInc()
+			tracing.Fail(span, nil, "error from backend service")
+			return nil, status.Error(codes.Internal, fmt.Sprintf("backend service returned %d results for %d users", len(received), len(sent)))
+		}
+		for k, index := range sent {
+			results[index] = &pb.UsersAddResponse_Result{
+				Id:      received[k].GetId(),
+				Code:    received[k].GetCode(),
+				Message: received[k].GetMessage(),
+			}
 		}
 	}
 
+	ids := make([]uint64, 0, len(data))
+	for _, result := range results {
+		if codes.Code(result.GetCode()) == codes.OK {
+			ids = append(ids, result.GetId())
+		}
+	}
+
+	counter.SuccessRequestInc()
+	return &pb.UsersAddResponse{
+		Ids:     ids,
+		Results: results,
+	}, nil
+}
+
+// usersAdd streams users with the given indexes to the backend and returns its responses in the same order
+func (i implementation) usersAdd(ctx context.Context, atomic bool, data []*pb.UsersAddRequest_User, indexes []int) ([]*pb.BackendUsersAddResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/usersAdd")
+	defer span.End()
+
 	stream, err := i.client.UsersAdd(ctx)
 	if err != nil {
 		counter.ErrorCounterInc()
-		span.LogKV("error", "error during client connection")
+		tracing.Fail(span, err, "error during client connection")
 		loggerPkg.Logger.Log.Error(err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	waitCh := make(chan struct{})
-	result := make([]uint64, 0, len(data))
+	result := make([]*pb.BackendUsersAddResponse, 0, len(indexes))
 
 	var recvErr error
 
@@ -122,35 +273,40 @@ func (i implementation) UsersAdd(ctx context.Context, in *pb.UsersAddRequest) (*
 			}
 			if err != nil {
 				counter.ErrorCounterInc()
+				counter.FailedRequestInc()
 				loggerPkg.Logger.Log.Error(err.Error())
-				span.LogKV("error", "error from backend service")
-				recvErr = status.Error(codes.Internal, err.Error())
+				tracing.Fail(span, err, "error from backend service")
+				recvErr = status.Error(status.Code(err), status.Convert(err).Message())
 				close(waitCh)
 				return
 			}
-			result = append(result, inBackend.GetId())
+			result = append(result, inBackend)
 			log.Printf("Got message %d", inBackend.GetId())
 		}
 	}()
-	for _, row := range data {
+	for _, index := range indexes {
+		row := data[index]
 		counter.OutRequestInc()
 		if err := stream.Send(&pb.BackendUsersAddRequest{
 			Email:    row.Email,
 			Name:     row.Name,
 			Role:     row.Role,
 			Password: row.Password,
+			Atomic:   atomic,
 		}); err != nil {
+			// the backend has finished the stream, its status is returned by Recv
+			if err == io.EOF {
+				break
+			}
 			loggerPkg.Logger.Log.Error(err.Error(),
 				zap.String("email:", row.Email),
 				zap.String("name:", row.Name),
 				zap.String("role:", row.Role),
-				zap.String("password:", row.Password),
 			)
 			counter.ErrorCounterInc()
-			span.LogKV("error", "error sending data to backend service")
+			tracing.Fail(span, err, "error sending data to backend service")
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		counter.OutRequestInc()
 	}
 	stream.CloseSend()
 	<-waitCh
@@ -158,57 +314,55 @@ func (i implementation) UsersAdd(ctx context.Context, in *pb.UsersAddRequest) (*
 	if recvErr != nil {
 		return nil, recvErr
 	}
-
-	counter.SuccessRequestInc()
-	return &pb.UsersAddResponse{
-		Ids: result,
-	}, nil
+	return result, nil
 }
 
 func (i implementation) UserGet(ctx context.Context, in *pb.UserGetRequest) (*pb.UserGetResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "ui/UserGet")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "ui/UserGet")
+	defer span.End()
 
 	//validate user's input
 	counter.InRequestInc()
-	if err := validatorPkg.ValidateUserId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+	if err := ValidateUserGet(in); err != nil {
 		counter.ErrorCounterInc()
-		span.LogKV("error", "validation error")
+		tracing.Fail(span, err, "validation error")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	counter.OutRequestInc()
 	out, err := i.client.UserGet(ctx, &pb.BackendUserGetRequest{
-		Id: in.GetId(),
+		Id:             in.GetId(),
+		IncludeDeleted: in.GetIncludeDeleted(),
 	})
 	if err != nil {
 		counter.ErrorCounterInc()
 		counter.FailedRequestInc()
-		span.LogKV("error", "error from backend service")
-		return nil, status.Error(codes.Internal, err.Error())
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
 	}
 	counter.SuccessRequestInc()
+	setETag(ctx, out.GetVersion())
 
 	return &pb.UserGetResponse{
-		Id:    out.GetId(),
-		Email: out.GetEmail(),
-		Name:  out.GetName(),
-		Role:  out.GetRole(),
+		Id:        out.GetId(),
+		Email:     out.GetEmail(),
+		Name:      out.GetName(),
+		Role:      out.GetRole(),
+		DeletedAt: out.GetDeletedAt(),
+		Version:   out.GetVersion(),
 	}, nil
 }
 
 func (i implementation) UserList(ctx context.Context, in *pb.UserListRequest) (*pb.UserListResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "ui/UserList")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "ui/UserList")
+	defer span.End()
 
 	counter.InRequestInc()
 
-	if in.GetOrder().GetField() != "" {
-		err := validatorPkg.ValidateSortingField(in.GetOrder().GetField())
-		if err != nil {
-			span.LogKV("error", "validation error")
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		}
+	if err := ValidateUserList(in); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	ctxData, ok := metadata.FromIncomingContext(ctx)
@@ -216,39 +370,13 @@ func (i implementation) UserList(ctx context.Context, in *pb.UserListRequest) (*
 		log.Println(ctxData.Get("custom"))
 	}
 
-	var recPerPage, pageNum uint64
-	recPerPage = in.GetRecPerPage()
-	pageNum = in.GetPageNum()
-
-	sortingOrder := models.SortingOrder{
-		Field:      in.GetOrder().GetField(),
-		Descending: in.GetOrder().GetDescending(),
-	}
-	if sortingOrder.Field == "" {
-		sortingOrder.Field = config.DefaultSortingField
-	}
-
-	if recPerPage == 0 {
-		recPerPage = config.DefaultRecPerPage
-	}
-	if pageNum == 0 {
-		pageNum = config.DefaultPageNum
-	}
-
 	counter.OutRequestInc()
-	users, err := i.client.UserList(ctx, &pb.BackendUserListRequest{
-		RecPerPage: &recPerPage,
-		PageNum:    &pageNum,
-		Order: &pb.BackendUserListRequest_SortingOrder{
-			Field:      sortingOrder.Field,
-			Descending: sortingOrder.Descending,
-		},
-	})
+	users, err := i.client.UserList(ctx, BackendUserListRequest(in))
 	if err != nil {
 		counter.ErrorCounterInc()
 		counter.FailedRequestInc()
-		span.LogKV("error", "error from backend service")
-		return nil, status.Error(codes.Internal, err.Error())
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
 	}
 
 	counter.SuccessRequestInc()
@@ -256,78 +384,134 @@ func (i implementation) UserList(ctx context.Context, in *pb.UserListRequest) (*
 	result := make([]*pb.UserListResponse_User, 0, len(users.Users))
 	for _, user := range users.Users {
 		result = append(result, &pb.UserListResponse_User{
-			Id:    uint64(user.GetId()),
-			Email: user.GetEmail(),
-			Name:  user.GetName(),
-			Role:  user.GetRole(),
+			Id:        uint64(user.GetId()),
+			Email:     user.GetEmail(),
+			Name:      user.GetName(),
+			Role:      user.GetRole(),
+			DeletedAt: user.GetDeletedAt(),
+			Version:   user.GetVersion(),
 		})
 	}
 
 	return &pb.UserListResponse{
-		Users: result,
+		Users:         result,
+		NextPageToken: users.GetNextPageToken(),
+		TotalCount:    users.GetTotalCount(),
+		PageCount:     users.GetPageCount(),
+		HasMore:       users.GetHasMore(),
 	}, nil
 
 }
 
 func (i implementation) UserUpdate(ctx context.Context, in *pb.UserUpdateRequest) (*pb.UserUpdateResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "ui/UserUpdate")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "ui/UserUpdate")
+	defer span.End()
 
 	// validate user's input
 	counter.InRequestInc()
-	if err := validatorPkg.ValidateUserId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+	if err := ValidateUserUpdate(in); err != nil {
 		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := validatorPkg.ValidatePassword(in.GetOldpassword()); err != nil {
+	// version in the request takes precedence over If-Match header of the gateway
+	version := in.Version
+	if version == nil {
+		var err error
+		if version, err = versionFromIfMatch(ctx); err != nil {
+			counter.ErrorCounterInc()
+			tracing.Fail(span, err, "validation error")
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	counter.OutRequestInc()
+	out, err := i.client.UserUpdate(ctx, &pb.BackendUserUpdateRequest{
+		Id:          in.GetId(),
+		Email:       in.GetEmail(),
+		Name:        in.GetName(),
+		Role:        in.GetRole(),
+		Password:    in.GetPassword(),
+		Oldpassword: in.GetOldpassword(),
+		Version:     version,
+	})
+	if err != nil {
+		counter.FailedRequestInc()
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+	setETag(ctx, out.GetVersion())
+
+	return &pb.UserUpdateResponse{
+		Version: out.GetVersion(),
+	}, nil
+}
+
+func (i implementation) UserPatch(ctx context.Context, in *pb.UserPatchRequest) (*pb.UserPatchResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/UserPatch")
+	defer span.End()
+
+	// validate user's input
+	counter.InRequestInc()
+	if err := validatorPkg.ValidateUserId(strconv.FormatUint(in.GetId(), 10)); err != nil {
 		counter.ErrorCounterInc()
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate([]string{
-		in.GetEmail(),
-		in.GetName(),
-		in.GetRole(),
-		in.GetPassword(),
-	})); err != nil {
+	if err := validatorPkg.ValidateUpdateMask(in.GetUpdateMask().GetPaths()); err != nil {
 		counter.ErrorCounterInc()
-		span.LogKV("error", "validation error")
+		tracing.Fail(span, err, "validation error")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// version in the request takes precedence over If-Match header of the gateway
+	version := in.Version
+	if version == nil {
+		var err error
+		if version, err = versionFromIfMatch(ctx); err != nil {
+			counter.ErrorCounterInc()
+			tracing.Fail(span, err, "validation error")
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	counter.OutRequestInc()
-	if _, err := i.client.UserUpdate(ctx, &pb.BackendUserUpdateRequest{
+	out, err := i.client.UserPatch(ctx, &pb.BackendUserPatchRequest{
 		Id:          in.GetId(),
 		Email:       in.GetEmail(),
 		Name:        in.GetName(),
 		Role:        in.GetRole(),
 		Password:    in.GetPassword(),
 		Oldpassword: in.GetOldpassword(),
-	}); err != nil {
+		UpdateMask:  in.GetUpdateMask(),
+		Version:     version,
+	})
+	if err != nil {
 		counter.FailedRequestInc()
 		counter.ErrorCounterInc()
-		span.LogKV("error", "error from backend service")
-		return nil, status.Error(codes.Internal, err.Error())
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
 	}
 	counter.SuccessRequestInc()
-	return &pb.UserUpdateResponse{}, nil
+	setETag(ctx, out.GetVersion())
+
+	return &pb.UserPatchResponse{
+		Version: out.GetVersion(),
+	}, nil
 }
 
 func (i implementation) UserDelete(ctx context.Context, in *pb.UserDeleteRequest) (*pb.UserDeleteResponse, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "ui/UserUpdate")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "ui/UserDelete")
+	defer span.End()
 
 	// validate user's input
 	counter.InRequestInc()
-	if err := validatorPkg.ValidateUserId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+	if err := ValidateUserDelete(in); err != nil {
 		counter.ErrorCounterInc()
-		span.LogKV("error", "validation error")
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-	if err := validatorPkg.ValidatePassword(in.GetPassword()); err != nil {
-		counter.ErrorCounterInc()
-		span.LogKV("error", "validation error")
+		tracing.Fail(span, err, "validation error")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -338,9 +522,87 @@ func (i implementation) UserDelete(ctx context.Context, in *pb.UserDeleteRequest
 	}); err != nil {
 		counter.ErrorCounterInc()
 		counter.FailedRequestInc()
-		span.LogKV("error", "error from backend service")
-		return nil, status.Error(codes.Internal, err.Error())
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
 	}
 	counter.SuccessRequestInc()
 	return &pb.UserDeleteResponse{}, nil
 }
+
+func (i implementation) UserRestore(ctx context.Context, in *pb.UserRestoreRequest) (*pb.UserRestoreResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/UserRestore")
+	defer span.End()
+
+	// validate user's input
+	counter.InRequestInc()
+	if err := validatorPkg.ValidateUserId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	counter.OutRequestInc()
+	if _, err := i.client.UserRestore(ctx, &pb.BackendUserRestoreRequest{
+		Id: in.GetId(),
+	}); err != nil {
+		counter.ErrorCounterInc()
+		counter.FailedRequestInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+	return &pb.UserRestoreResponse{}, nil
+}
+
+func (i implementation) UserPurge(ctx context.Context, in *pb.UserPurgeRequest) (*pb.UserPurgeResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/UserPurge")
+	defer span.End()
+
+	// validate user's input
+	counter.InRequestInc()
+	if err := validatorPkg.ValidateUserId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	counter.OutRequestInc()
+	if _, err := i.client.UserPurge(ctx, &pb.BackendUserPurgeRequest{
+		Id: in.GetId(),
+	}); err != nil {
+		counter.ErrorCounterInc()
+		counter.FailedRequestInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+	return &pb.UserPurgeResponse{}, nil
+}
+
+func userFilter(in *pb.UserListRequest_Filter) models.UserFilter {
+	return models.UserFilter{
+		Roles:         in.GetRoles(),
+		EmailContains: in.GetEmailContains(),
+		EmailPrefix:   in.GetEmailPrefix(),
+		NameContains:  in.GetNameContains(),
+		NamePrefix:    in.GetNamePrefix(),
+		IdFrom:        uint(in.GetIdFrom()),
+		IdTo:          uint(in.GetIdTo()),
+
+		IncludeDeleted: in.GetIncludeDeleted(),
+	}
+}
+
+func backendUserFilter(filter models.UserFilter) *pb.BackendUserListRequest_Filter {
+	return &pb.BackendUserListRequest_Filter{
+		Roles:         filter.Roles,
+		EmailContains: filter.EmailContains,
+		EmailPrefix:   filter.EmailPrefix,
+		NameContains:  filter.NameContains,
+		NamePrefix:    filter.NamePrefix,
+		IdFrom:        uint64(filter.IdFrom),
+		IdTo:          uint64(filter.IdTo),
+
+		IncludeDeleted: filter.IncludeDeleted,
+	}
+}
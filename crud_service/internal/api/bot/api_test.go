@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// backendStub fails every call by the error
+type backendStub struct {
+	pb.BackendClient
+	err error
+}
+
+func (b backendStub) UserCreate(context.Context, *pb.BackendUserCreateRequest, ...grpc.CallOption) (*pb.BackendUserCreateResponse, error) {
+	return nil, b.err
+}
+
+func (b backendStub) UserDelete(context.Context, *pb.BackendUserDeleteRequest, ...grpc.CallOption) (*pb.BackendUserDeleteResponse, error) {
+	return nil, b.err
+}
+
+func TestBackendErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("user create", func(t *testing.T) {
+		// arrange
+		api := New(backendStub{err: status.Error(codes.AlreadyExists, "user already exists")}, nil)
+
+		// act
+		_, err := api.UserCreate(ctx, &pb.UserCreateRequest{
+			Email:    "test01@dummy.com",
+			Name:     "Test Tester",
+			Role:     models.RoleUserName,
+			Password: "123456",
+		})
+
+		// assert
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Equal(t, "user already exists", status.Convert(err).Message())
+	})
+
+	t.Run("user delete", func(t *testing.T) {
+		// arrange
+		api := New(backendStub{err: status.Error(codes.NotFound, "user does not exists")}, nil)
+
+		// act
+		_, err := api.UserDelete(ctx, &pb.UserDeleteRequest{Id: 1})
+
+		// assert
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, "user does not exists", status.Convert(err).Message())
+	})
+}
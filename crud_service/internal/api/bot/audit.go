@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/counter"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i implementation) AuditList(ctx context.Context, in *pb.AuditListRequest) (*pb.AuditListResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/AuditList")
+	defer span.End()
+
+	counter.InRequestInc()
+
+	filter := models.AuditFilter{
+		ActorId:  uint(in.GetFilter().GetActorId()),
+		TargetId: uint(in.GetFilter().GetTargetId()),
+		Actions:  in.GetFilter().GetActions(),
+	}
+	if in.GetFilter().GetFrom() != nil {
+		filter.From = in.GetFilter().GetFrom().AsTime()
+	}
+	if in.GetFilter().GetTo() != nil {
+		filter.To = in.GetFilter().GetTo().AsTime()
+	}
+	if err := validatorPkg.ValidateAuditFilter(filter); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	counter.OutRequestInc()
+	out, err := i.client.AuditList(ctx, &pb.BackendAuditListRequest{
+		RecPerPage: in.RecPerPage,
+		PageNum:    in.PageNum,
+		Filter: &pb.BackendAuditListRequest_Filter{
+			ActorId:  in.GetFilter().GetActorId(),
+			TargetId: in.GetFilter().GetTargetId(),
+			Actions:  in.GetFilter().GetActions(),
+			From:     in.GetFilter().GetFrom(),
+			To:       in.GetFilter().GetTo(),
+		},
+	})
+	if err != nil {
+		counter.ErrorCounterInc()
+		counter.FailedRequestInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+
+	counter.SuccessRequestInc()
+
+	result := make([]*pb.AuditListResponse_Event, 0, len(out.GetEvents()))
+	for _, event := range out.GetEvents() {
+		changes := make(map[string]*pb.AuditListResponse_FieldChange, len(event.GetChanges()))
+		for field, change := range event.GetChanges() {
+			changes[field] = &pb.AuditListResponse_FieldChange{
+				Old: change.GetOld(),
+				New: change.GetNew(),
+			}
+		}
+		result = append(result, &pb.AuditListResponse_Event{
+			Id:         event.GetId(),
+			ActorId:    event.GetActorId(),
+			ActorEmail: event.GetActorEmail(),
+			Action:     event.GetAction(),
+			TargetId:   event.GetTargetId(),
+			Changes:    changes,
+			RequestId:  event.GetRequestId(),
+			CreatedAt:  event.GetCreatedAt(),
+		})
+	}
+
+	return &pb.AuditListResponse{
+		Events:     result,
+		TotalCount: out.GetTotalCount(),
+		PageCount:  out.GetPageCount(),
+		HasMore:    out.GetHasMore(),
+	}, nil
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Version of the user is passed through the gateway in ETag and If-Match HTTP headers
+const (
+	etagHeader    = "etag"
+	ifMatchHeader = "if-match"
+)
+
+var errInvalidIfMatch = errors.New("invalid If-Match header")
+
+func formatETag(version uint64) string {
+	return strconv.Quote(strconv.FormatUint(version, 10))
+}
+
+// setETag returns version of the user to the caller in the response header
+func setETag(ctx context.Context, version uint64) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(etagHeader, formatETag(version)))
+}
+
+// versionFromIfMatch returns version of the user expected by the caller.
+// Nil is returned if the header is not passed or matches any version.
+func versionFromIfMatch(ctx context.Context) (*uint64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(ifMatchHeader)) == 0 {
+		return nil, nil
+	}
+
+	value := strings.TrimSpace(md.Get(ifMatchHeader)[0])
+	if value == "*" {
+		return nil, nil
+	}
+	value = strings.TrimPrefix(value, "W/")
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return nil, errors.Wrapf(errInvalidIfMatch, "value: [%s]", value)
+	}
+	version, err := strconv.ParseUint(unquoted, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(errInvalidIfMatch, "value: [%s]", value)
+	}
+	return &version, nil
+}
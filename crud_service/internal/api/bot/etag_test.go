@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestVersionFromIfMatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ifMatchHeader, formatETag(3)))
+
+		// act
+		version, err := versionFromIfMatch(ctx)
+
+		// assert
+		require.NoError(t, err)
+		require.NotNil(t, version)
+		assert.Equal(t, uint64(3), *version)
+	})
+
+	t.Run("any version", func(t *testing.T) {
+		for _, ctx := range []context.Context{
+			context.Background(),
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs(ifMatchHeader, "*")),
+		} {
+			// act
+			version, err := versionFromIfMatch(ctx)
+
+			// assert
+			require.NoError(t, err)
+			assert.Nil(t, version)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ifMatchHeader, "3"))
+
+		// act
+		_, err := versionFromIfMatch(ctx)
+
+		// assert
+		require.ErrorIs(t, err, errInvalidIfMatch)
+	})
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"strconv"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+)
+
+// Requests are validated by the same functions whether they come over gRPC or Kafka
+
+func ValidateUserCreate(in *pb.UserCreateRequest) error {
+	return validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate([]string{
+		in.GetEmail(),
+		in.GetName(),
+		in.GetRole(),
+		in.GetPassword(),
+	}))
+}
+
+func ValidateUsersAddUser(in *pb.UsersAddRequest_User) error {
+	return validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate([]string{
+		in.GetEmail(),
+		in.GetName(),
+		in.GetRole(),
+		in.GetPassword(),
+	}))
+}
+
+func ValidateUserGet(in *pb.UserGetRequest) error {
+	return validatorPkg.ValidateUserId(strconv.FormatUint(in.GetId(), 10))
+}
+
+func ValidateUserList(in *pb.UserListRequest) error {
+	if in.GetOrder().GetField() != "" {
+		if err := validatorPkg.ValidateSortingField(in.GetOrder().GetField()); err != nil {
+			return err
+		}
+	}
+	return validatorPkg.ValidateUserFilter(userFilter(in.GetFilter()))
+}
+
+// ValidateUserUpdate checks the old password only if it is passed, since admins update users without it
+func ValidateUserUpdate(in *pb.UserUpdateRequest) error {
+	if err := validatorPkg.ValidateUserId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+		return err
+	}
+	if err := validateOptionalPassword(in.GetOldpassword()); err != nil {
+		return err
+	}
+	return validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate([]string{
+		in.GetEmail(),
+		in.GetName(),
+		in.GetRole(),
+		in.GetPassword(),
+	}))
+}
+
+// ValidateUserDelete checks the password only if it is passed, since admins delete users without it
+func ValidateUserDelete(in *pb.UserDeleteRequest) error {
+	if err := validatorPkg.ValidateUserId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+		return err
+	}
+	return validateOptionalPassword(in.GetPassword())
+}
+
+// validateOptionalPassword checks the password confirming the change, the backend decides whether it is required
+func validateOptionalPassword(pwd string) error {
+	if pwd == "" {
+		return nil
+	}
+	return validatorPkg.ValidatePassword(pwd)
+}
+
+// BackendUserListRequest fills defaults of the request, which are sent to the backend explicitly
+func BackendUserListRequest(in *pb.UserListRequest) *pb.BackendUserListRequest {
+	recPerPage := in.GetRecPerPage()
+	if recPerPage == 0 {
+		recPerPage = config.DefaultRecPerPage
+	}
+	pageNum := in.GetPageNum()
+	if pageNum == 0 {
+		pageNum = config.DefaultPageNum
+	}
+	field := in.GetOrder().GetField()
+	if field == "" {
+		field = config.DefaultSortingField
+	}
+
+	return &pb.BackendUserListRequest{
+		RecPerPage: &recPerPage,
+		PageNum:    &pageNum,
+		Order: &pb.BackendUserListRequest_SortingOrder{
+			Field:      field,
+			Descending: in.GetOrder().GetDescending(),
+		},
+		Filter:    backendUserFilter(userFilter(in.GetFilter())),
+		PageToken: in.PageToken,
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rolePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+)
+
+// TestMain loads the built-in roles into the registry of the validator
+func TestMain(m *testing.M) {
+	roles := rolePkg.NewRegistry(rolePkg.SourceFunc(func(context.Context) ([]models.Role, error) {
+		return []models.Role{
+			{Id: 1, Name: models.RoleAdminName},
+			{Id: 2, Name: models.RoleUserName},
+		}, nil
+	}))
+	if err := roles.Refresh(context.Background()); err != nil {
+		panic(err)
+	}
+	validatorPkg.SetRoleRegistry(roles)
+
+	os.Exit(m.Run())
+}
+
+func TestValidateUserUpdate(t *testing.T) {
+	request := func(oldPassword string) *pb.UserUpdateRequest {
+		return &pb.UserUpdateRequest{
+			Id:          1,
+			Email:       "test01@dummy.com",
+			Name:        "Test Tester",
+			Role:        models.RoleUserName,
+			Password:    "123456",
+			Oldpassword: oldPassword,
+		}
+	}
+
+	t.Run("without old password", func(t *testing.T) {
+		// act
+		err := ValidateUserUpdate(request(""))
+
+		// assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("with old password", func(t *testing.T) {
+		// act
+		err := ValidateUserUpdate(request("654321"))
+
+		// assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid old password", func(t *testing.T) {
+		// act
+		err := ValidateUserUpdate(request("1"))
+
+		// assert
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateUserDelete(t *testing.T) {
+	t.Run("without password", func(t *testing.T) {
+		// act
+		err := ValidateUserDelete(&pb.UserDeleteRequest{Id: 1})
+
+		// assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid password", func(t *testing.T) {
+		// act
+		err := ValidateUserDelete(&pb.UserDeleteRequest{Id: 1, Password: "1"})
+
+		// assert
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	rolePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/counter"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RoleSource loads roles from the backend service into the registry
+func RoleSource(client pb.BackendClient) rolePkg.Source {
+	return rolePkg.SourceFunc(func(ctx context.Context) ([]models.Role, error) {
+		out, err := client.RoleList(ctx, &pb.BackendRoleListRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]models.Role, 0, len(out.GetRoles()))
+		for _, role := range out.GetRoles() {
+			result = append(result, models.Role{
+				Id:   uint8(role.GetId()),
+				Name: role.GetName(),
+			})
+		}
+		return result, nil
+	})
+}
+
+func (i implementation) RoleCreate(ctx context.Context, in *pb.RoleCreateRequest) (*pb.RoleCreateResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/RoleCreate")
+	defer span.End()
+	span.SetAttributes(attribute.String("role.name", in.GetName()))
+
+	counter.InRequestInc()
+	if err := validatorPkg.ValidateRoleName(in.GetName()); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	counter.OutRequestInc()
+	out, err := i.client.RoleCreate(ctx, &pb.BackendRoleCreateRequest{
+		Name: in.GetName(),
+	})
+	if err != nil {
+		counter.FailedRequestInc()
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+	i.refreshRoles(ctx)
+
+	return &pb.RoleCreateResponse{
+		Id: out.GetId(),
+	}, nil
+}
+
+func (i implementation) RoleList(ctx context.Context, in *pb.RoleListRequest) (*pb.RoleListResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/RoleList")
+	defer span.End()
+
+	counter.InRequestInc()
+	counter.OutRequestInc()
+	out, err := i.client.RoleList(ctx, &pb.BackendRoleListRequest{})
+	if err != nil {
+		counter.FailedRequestInc()
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+
+	result := make([]*pb.RoleListResponse_Role, 0, len(out.GetRoles()))
+	for _, role := range out.GetRoles() {
+		result = append(result, &pb.RoleListResponse_Role{
+			Id:   role.GetId(),
+			Name: role.GetName(),
+		})
+	}
+
+	return &pb.RoleListResponse{
+		Roles: result,
+	}, nil
+}
+
+func (i implementation) RoleUpdate(ctx context.Context, in *pb.RoleUpdateRequest) (*pb.RoleUpdateResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/RoleUpdate")
+	defer span.End()
+	span.SetAttributes(attribute.Int64("role.id", int64(in.GetId())), attribute.String("role.name", in.GetName()))
+
+	counter.InRequestInc()
+	if err := validatorPkg.ValidateRoleId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validatorPkg.ValidateRoleName(in.GetName()); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	counter.OutRequestInc()
+	if _, err := i.client.RoleUpdate(ctx, &pb.BackendRoleUpdateRequest{
+		Id:   in.GetId(),
+		Name: in.GetName(),
+	}); err != nil {
+		counter.FailedRequestInc()
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+	i.refreshRoles(ctx)
+
+	return &pb.RoleUpdateResponse{}, nil
+}
+
+func (i implementation) RoleDelete(ctx context.Context, in *pb.RoleDeleteRequest) (*pb.RoleDeleteResponse, error) {
+	ctx, span := tracer.Start(ctx, "ui/RoleDelete")
+	defer span.End()
+	span.SetAttributes(attribute.Int64("role.id", int64(in.GetId())))
+
+	counter.InRequestInc()
+	if err := validatorPkg.ValidateRoleId(strconv.FormatUint(in.GetId(), 10)); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	counter.OutRequestInc()
+	if _, err := i.client.RoleDelete(ctx, &pb.BackendRoleDeleteRequest{
+		Id: in.GetId(),
+	}); err != nil {
+		counter.FailedRequestInc()
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error from backend service")
+		return nil, status.Error(status.Code(err), status.Convert(err).Message())
+	}
+	counter.SuccessRequestInc()
+	i.refreshRoles(ctx)
+
+	return &pb.RoleDeleteResponse{}, nil
+}
+
+// refreshRoles updates the registry used by validation right after roles have been changed.
+// On failure the registry is updated by the next periodic refresh.
+func (i implementation) refreshRoles(ctx context.Context) {
+	if err := i.roles.Refresh(ctx); err != nil {
+		loggerPkg.Logger.Log.Error(fmt.Sprintf("error during roles refresh [%v]", err))
+	}
+}
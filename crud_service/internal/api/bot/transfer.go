@@ -0,0 +1,276 @@
+package api
+
+import (
+	"bufio"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/counter"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/userfile"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UsersExport writes users to the file of the requested format and streams it in chunks
+func (i implementation) UsersExport(in *pb.UsersExportRequest, stream pb.Admin_UsersExportServer) error {
+	ctx, span := tracer.Start(stream.Context(), "ui/UsersExport")
+	defer span.End()
+
+	counter.InRequestInc()
+	format, err := userfile.ParseFormat(in.GetFormat())
+	if err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter := userFilter(in.GetFilter())
+	if err := validatorPkg.ValidateUserFilter(filter); err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	chunks := bufio.NewWriterSize(chunkWriter(func(data []byte) error {
+		return stream.Send(&pb.UsersExportResponse{
+			Data: data,
+		})
+	}), config.FileChunkSize)
+	encoder, err := userfile.NewEncoder(chunks, format, in.GetFields(), in.GetHeader())
+	if err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	counter.OutRequestInc()
+	backendStream, err := i.client.UsersExport(ctx, &pb.BackendUsersExportRequest{
+		Filter: backendUserFilter(filter),
+	})
+	if err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error during client connection")
+		return status.Error(codes.Internal, err.Error())
+	}
+	for {
+		out, err := backendStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			counter.ErrorCounterInc()
+			counter.FailedRequestInc()
+			tracing.Fail(span, err, "error from backend service")
+			return status.Error(status.Code(err), status.Convert(err).Message())
+		}
+		for _, user := range out.GetUsers() {
+			if err := encoder.Encode(exportedUser(user)); err != nil {
+				counter.ErrorCounterInc()
+				tracing.Fail(span, err, "write error")
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	if err := encoder.Flush(); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := chunks.Flush(); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	counter.SuccessRequestInc()
+	return nil
+}
+
+// chunkWriter sends every written buffer as a chunk of the file
+type chunkWriter func(data []byte) error
+
+func (w chunkWriter) Write(data []byte) (int, error) {
+	// the buffer can be reused by the caller after Write returns
+	chunk := make([]byte, len(data))
+	copy(chunk, data)
+	if err := w(chunk); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func exportedUser(user *pb.BackendUserListResponse_User) models.User {
+	result := models.User{
+		Id:      uint(user.GetId()),
+		Email:   user.GetEmail(),
+		Name:    user.GetName(),
+		Role:    user.GetRole(),
+		Version: user.GetVersion(),
+	}
+	if user.GetDeletedAt() != nil {
+		deletedAt := user.GetDeletedAt().AsTime()
+		result.DeletedAt = &deletedAt
+	}
+	return result
+}
+
+// UsersImport reads users from the streamed file and passes them to the backend one by one.
+// Lines which cannot be read are not sent to the backend and are added to its report.
+func (i implementation) UsersImport(stream pb.Admin_UsersImportServer) error {
+	ctx, span := tracer.Start(stream.Context(), "ui/UsersImport")
+	defer span.End()
+
+	counter.InRequestInc()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "invalid argument: data is empty")
+		return status.Error(codes.InvalidArgument, "data is empty")
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	options := first.GetOptions()
+	span.SetAttributes(
+		attribute.Bool("import.dry_run", options.GetDryRun()),
+		attribute.String("import.on_duplicate", options.GetOnDuplicate().String()),
+	)
+
+	format, err := userfile.ParseFormat(options.GetFormat())
+	if err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	file, fileWriter := io.Pipe()
+	defer file.Close()
+	go receiveFile(stream, first.GetData(), fileWriter)
+
+	decoder, err := userfile.NewDecoder(file, format, options.GetHeader())
+	if err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "validation error")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	counter.OutRequestInc()
+	backendStream, err := i.client.UsersImport(ctx)
+	if err != nil {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error during client connection")
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := backendStream.Send(&pb.BackendUsersImportRequest{
+		Options: &pb.BackendUsersImportRequest_Options{
+			DryRun:      options.GetDryRun(),
+			OnDuplicate: pb.BackendUsersImportRequest_DuplicatePolicy(options.GetOnDuplicate()),
+		},
+	}); err != nil && err != io.EOF {
+		counter.ErrorCounterInc()
+		tracing.Fail(span, err, "error sending data to backend service")
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	var rowErrors []*pb.UsersImportResponse_Error
+	for {
+		record, err := decoder.Decode()
+		if err == io.EOF {
+			break
+		}
+		var rowErr *userfile.RowError
+		if errors.As(err, &rowErr) {
+			rowErrors = append(rowErrors, &pb.UsersImportResponse_Error{
+				Line:    uint64(rowErr.Line),
+				Code:    int32(codes.InvalidArgument),
+				Message: rowErr.Err.Error(),
+			})
+			continue
+		}
+		if err != nil {
+			counter.ErrorCounterInc()
+			tracing.Fail(span, err, "read error")
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if err := backendStream.Send(&pb.BackendUsersImportRequest{
+			User: &pb.BackendUsersImportRequest_User{
+				Line:     uint64(record.Line),
+				Email:    record.User.Email,
+				Name:     record.User.Name,
+				Role:     record.User.Role,
+				Password: record.User.Password,
+			},
+		}); err != nil {
+			// the backend has finished the stream, its status is returned by CloseAndRecv
+			if err == io.EOF {
+				break
+			}
+			counter.ErrorCounterInc()
+			tracing.Fail(span, err, "error sending data to backend service")
+			loggerPkg.Logger.Log.Error(err.Error())
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	out, err := backendStream.CloseAndRecv()
+	if err != nil {
+		counter.ErrorCounterInc()
+		counter.FailedRequestInc()
+		tracing.Fail(span, err, "error from backend service")
+		return status.Error(status.Code(err), status.Convert(err).Message())
+	}
+
+	result := make([]*pb.UsersImportResponse_Error, 0, len(out.GetErrors())+len(rowErrors))
+	for _, e := range out.GetErrors() {
+		result = append(result, &pb.UsersImportResponse_Error{
+			Line:    e.GetLine(),
+			Email:   e.GetEmail(),
+			Code:    e.GetCode(),
+			Message: e.GetMessage(),
+		})
+	}
+	result = append(result, rowErrors...)
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].GetLine() < result[j].GetLine()
+	})
+
+	counter.SuccessRequestInc()
+	return stream.SendAndClose(&pb.UsersImportResponse{
+		DryRun:  out.GetDryRun(),
+		Total:   out.GetTotal() + uint64(len(rowErrors)),
+		Created: out.GetCreated(),
+		Updated: out.GetUpdated(),
+		Skipped: out.GetSkipped(),
+		Failed:  out.GetFailed() + uint64(len(rowErrors)),
+		Errors:  result,
+	})
+}
+
+// receiveFile writes chunks of the stream to the pipe until the end of the stream
+func receiveFile(stream pb.Admin_UsersImportServer, first []byte, w *io.PipeWriter) {
+	if _, err := w.Write(first); err != nil {
+		w.CloseWithError(err)
+		return
+	}
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			w.Close()
+			return
+		}
+		if err != nil {
+			w.CloseWithError(err)
+			return
+		}
+		if _, err := w.Write(in.GetData()); err != nil {
+			// the reader is closed, so the rest of the file is not needed
+			w.CloseWithError(err)
+			return
+		}
+	}
+}
@@ -1,24 +1,70 @@
+// This package contains password hashing and verification of users' credentials
 package auth
 
 import (
-	"crypto/md5"
-	"fmt"
-
+	"github.com/pkg/errors"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 )
 
+var ErrWrongPassword = errors.New("wrong old password")
+
+// hashers contains all supported hashing algorithms. The default one is used to
+// generate new hashes, the others are kept to verify hashes stored earlier.
+var hashers = []Hasher{
+	newArgon2idHasher(config.Argon2Time, config.Argon2Memory, config.Argon2Threads, config.Argon2KeyLen, config.Argon2SaltLen),
+	newBcryptHasher(config.BcryptCost),
+	newMd5Hasher(config.Md5HashKey),
+}
+
 func VerifyPassword(user models.User, pwd string) error {
-	pwdHash := GenHashPassword(pwd)
-	if user.Password != pwdHash {
-		return fmt.Errorf("wrong old password")
+	hasher := findHasher(user.Password)
+	if hasher == nil {
+		return ErrWrongPassword
+	}
+
+	ok, err := hasher.Verify(user.Password, pwd)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrWrongPassword
 	}
 	return nil
 }
 
-func GenHashPassword(password string) string {
-	// use MD5 has to prevent storage of raw password in the storage
-	// this is not enough secure approach, but it's better then nothing
-	pwdHash := md5.Sum([]byte(password + config.Md5HashKey))
-	return fmt.Sprintf("%x", pwdHash)
+func GenHashPassword(password string) (string, error) {
+	hasher := defaultHasher()
+	if hasher == nil {
+		return "", errors.Errorf("unsupported password hash algorithm <%v>", config.PasswordHashAlgorithm)
+	}
+	return hasher.Hash(password)
+}
+
+// NeedsRehash reports whether the stored hash was made by an algorithm or with
+// parameters other than the current default ones and has to be regenerated.
+func NeedsRehash(hash string) bool {
+	hasher := findHasher(hash)
+	if hasher == nil || hasher != defaultHasher() {
+		return true
+	}
+	return hasher.NeedsRehash(hash)
+}
+
+func defaultHasher() Hasher {
+	for _, hasher := range hashers {
+		if hasher.Name() == config.PasswordHashAlgorithm {
+			return hasher
+		}
+	}
+	return nil
+}
+
+func findHasher(hash string) Hasher {
+	for _, hasher := range hashers {
+		if hasher.Match(hash) {
+			return hasher
+		}
+	}
+	return nil
 }
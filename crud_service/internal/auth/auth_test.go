@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+)
+
+func TestGenHashPassword(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+
+		// act
+		first, err := GenHashPassword(f.password)
+		require.NoError(t, err)
+		second, err := GenHashPassword(f.password)
+		require.NoError(t, err)
+
+		// assert
+		assert.True(t, strings.HasPrefix(first, "$"+config.PasswordHashAlgorithm+"$"))
+		assert.NotEqual(t, first, second, "hashes must be salted randomly")
+		assert.False(t, NeedsRehash(first))
+	})
+}
+
+func TestVerifyPassword(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		t.Run("argon2id", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			var err error
+			f.user.Password, err = newArgon2idHasher(1, 1024, 1, 32, 16).Hash(f.password)
+			require.NoError(t, err)
+
+			// act
+			err = VerifyPassword(f.user, f.password)
+
+			// assert
+			require.NoError(t, err)
+		})
+
+		t.Run("bcrypt", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			var err error
+			f.user.Password, err = newBcryptHasher(4).Hash(f.password)
+			require.NoError(t, err)
+
+			// act
+			err = VerifyPassword(f.user, f.password)
+
+			// assert
+			require.NoError(t, err)
+		})
+
+		t.Run("legacy md5", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			f.user.Password = f.legacyHash
+
+			// act
+			err := VerifyPassword(f.user, f.password)
+
+			// assert
+			require.NoError(t, err)
+			assert.True(t, NeedsRehash(f.user.Password))
+		})
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("wrong password", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			var err error
+			f.user.Password, err = GenHashPassword(f.password)
+			require.NoError(t, err)
+
+			// act
+			err = VerifyPassword(f.user, "654321")
+
+			// assert
+			require.ErrorIs(t, err, ErrWrongPassword)
+		})
+
+		t.Run("wrong legacy password", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			f.user.Password = f.legacyHash
+
+			// act
+			err := VerifyPassword(f.user, "654321")
+
+			// assert
+			require.ErrorIs(t, err, ErrWrongPassword)
+		})
+
+		t.Run("unknown hash format", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			f.user.Password = f.password
+
+			// act
+			err := VerifyPassword(f.user, f.password)
+
+			// assert
+			require.ErrorIs(t, err, ErrWrongPassword)
+		})
+	})
+}
+
+func TestNeedsRehash(t *testing.T) {
+	t.Run("outdated parameters", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+		hash, err := newArgon2idHasher(1, 1024, 1, 32, 16).Hash(f.password)
+		require.NoError(t, err)
+
+		// act
+		result := NeedsRehash(hash)
+
+		// assert
+		assert.True(t, result)
+	})
+
+	t.Run("other algorithm", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+		hash, err := newBcryptHasher(config.BcryptCost).Hash(f.password)
+		require.NoError(t, err)
+
+		// act
+		result := NeedsRehash(hash)
+
+		// assert
+		assert.True(t, result)
+	})
+}
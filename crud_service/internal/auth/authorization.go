@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rule describes who is allowed to call RPC
+type Rule struct {
+	// Public methods are available without access token
+	Public bool
+	// Roles can call the method for any user
+	Roles []string
+	// Self allows any authenticated user to call the method for own record only.
+	// Request must have GetId() method returning id of the target user.
+	// The user cannot change own role.
+	Self bool
+}
+
+// Permissions maps full RPC method name to the rule. Methods missing in the map are denied.
+type Permissions map[string]Rule
+
+func (p Permissions) PublicMethods() []string {
+	methods := make([]string, 0, len(p))
+	for method, rule := range p {
+		if rule.Public {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
+
+type targetUser interface {
+	GetId() uint64
+}
+
+type targetRole interface {
+	GetRole() string
+}
+
+// AuthorizeUnaryServerInterceptor checks the caller's permission to call the method.
+// It must be chained after UnaryServerInterceptor which puts identity into the context.
+func AuthorizeUnaryServerInterceptor(permissions Permissions) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := permissions.Authorize(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthorizeStreamServerInterceptor checks the caller's permission to call the streaming method.
+// Requests of the stream are not inspected, so self rule is not applicable here.
+func AuthorizeStreamServerInterceptor(permissions Permissions) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := permissions.Authorize(ss.Context(), info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// Authorize checks the permission of the caller from the context to call the method with the request,
+// req may be nil if the request is not known
+func (p Permissions) Authorize(ctx context.Context, method string, req interface{}) error {
+	_, span := tracer.Start(ctx, "auth/authorize")
+	defer span.End()
+	span.SetAttributes(semconv.RPCMethodKey.String(method))
+
+	rule, ok := p[method]
+	if !ok {
+		tracing.Fail(span, nil, "permission denied: unknown method")
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if rule.Public {
+		return nil
+	}
+
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		tracing.Fail(span, nil, "permission denied: unauthenticated caller")
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	span.SetAttributes(semconv.EnduserIDKey.Int64(int64(identity.Id)), semconv.EnduserRoleKey.String(identity.Role))
+
+	for _, role := range rule.Roles {
+		if identity.Role == role {
+			return nil
+		}
+	}
+
+	if rule.Self && req != nil {
+		if target, ok := req.(targetUser); ok && target.GetId() == uint64(identity.Id) {
+			if target, ok := req.(targetRole); ok && target.GetRole() != "" && target.GetRole() != identity.Role {
+				tracing.Fail(span, nil, "permission denied: role change")
+				return status.Error(codes.PermissionDenied, "permission denied: role cannot be changed")
+			}
+			return nil
+		}
+	}
+
+	tracing.Fail(span, nil, "permission denied")
+	return status.Error(codes.PermissionDenied, "permission denied")
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc"
+)
+
+var testPermissions = Permissions{
+	"/test/Login":      {Public: true},
+	"/test/UserDelete": {Roles: []string{"Admin"}},
+	"/test/UserUpdate": {Roles: []string{"Admin"}, Self: true},
+}
+
+func authorize(ctx context.Context, method string, req interface{}) error {
+	_, err := AuthorizeUnaryServerInterceptor(testPermissions)(ctx, req, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+	return err
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		t.Run("public method", func(t *testing.T) {
+			// act
+			err := authorize(context.Background(), "/test/Login", &pb.BackendLoginRequest{})
+
+			// assert
+			require.NoError(t, err)
+		})
+
+		t.Run("admin", func(t *testing.T) {
+			// arrange
+			ctx := ContextWithIdentity(context.Background(), &Identity{Id: 1, Role: "Admin"})
+
+			// act
+			err := authorize(ctx, "/test/UserDelete", &pb.BackendUserDeleteRequest{Id: 2})
+
+			// assert
+			require.NoError(t, err)
+		})
+
+		t.Run("user updates own record", func(t *testing.T) {
+			// arrange
+			ctx := ContextWithIdentity(context.Background(), &Identity{Id: 2, Role: "User"})
+
+			// act
+			err := authorize(ctx, "/test/UserUpdate", &pb.BackendUserUpdateRequest{Id: 2, Role: "User"})
+
+			// assert
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("user deletes record", func(t *testing.T) {
+			// arrange
+			ctx := ContextWithIdentity(context.Background(), &Identity{Id: 2, Role: "User"})
+
+			// act
+			err := authorize(ctx, "/test/UserDelete", &pb.BackendUserDeleteRequest{Id: 2})
+
+			// assert
+			require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied")
+		})
+
+		t.Run("user updates other record", func(t *testing.T) {
+			// arrange
+			ctx := ContextWithIdentity(context.Background(), &Identity{Id: 2, Role: "User"})
+
+			// act
+			err := authorize(ctx, "/test/UserUpdate", &pb.BackendUserUpdateRequest{Id: 3, Role: "User"})
+
+			// assert
+			require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied")
+		})
+
+		t.Run("user changes own role", func(t *testing.T) {
+			// arrange
+			ctx := ContextWithIdentity(context.Background(), &Identity{Id: 2, Role: "User"})
+
+			// act
+			err := authorize(ctx, "/test/UserUpdate", &pb.BackendUserUpdateRequest{Id: 2, Role: "Admin"})
+
+			// assert
+			require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: role cannot be changed")
+		})
+
+		t.Run("unknown method", func(t *testing.T) {
+			// arrange
+			ctx := ContextWithIdentity(context.Background(), &Identity{Id: 1, Role: "Admin"})
+
+			// act
+			err := authorize(ctx, "/test/Unknown", nil)
+
+			// assert
+			require.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied")
+		})
+	})
+}
+
+func TestPublicMethods(t *testing.T) {
+	assert.Equal(t, []string{"/test/Login"}, testPermissions.PublicMethods())
+}
@@ -0,0 +1,11 @@
+package auth
+
+// Hasher generates and verifies password hashes of one algorithm.
+// Hashes are self-describing, so the algorithm and its parameters are read from the hash itself.
+type Hasher interface {
+	Name() string
+	Hash(password string) (string, error)
+	Verify(hash, password string) (bool, error)
+	Match(hash string) bool
+	NeedsRehash(hash string) bool
+}
@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/auth")
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+type identityKey struct{}
+
+func ContextWithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok
+}
+
+// UnaryServerInterceptor validates access token of every call except public methods
+// and puts the caller's identity into the context
+func UnaryServerInterceptor(tokens *TokenManager, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := makeMethodSet(publicMethods)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, tokens)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(tokens *TokenManager, publicMethods ...string) grpc.StreamServerInterceptor {
+	public := makeMethodSet(publicMethods)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := public[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), tokens)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// UnaryClientInterceptor forwards the access token of the incoming call to the outgoing one
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(forwardToken(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(forwardToken(ctx), desc, cc, method, opts...)
+	}
+}
+
+// ContextWithToken adds access token to the outgoing context
+func ContextWithToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, authorizationHeader, bearerPrefix+token)
+}
+
+// TokenFromContext returns access token added to the outgoing context by ContextWithToken
+func TokenFromContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok || len(md.Get(authorizationHeader)) == 0 {
+		return "", false
+	}
+	header := md.Get(authorizationHeader)[0]
+	if !strings.HasPrefix(header, bearerPrefix) || header == bearerPrefix {
+		return "", false
+	}
+	return strings.TrimPrefix(header, bearerPrefix), true
+}
+
+func authenticate(ctx context.Context, tokens *TokenManager) (context.Context, error) {
+	_, span := tracer.Start(ctx, "auth/authenticate")
+	defer span.End()
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(authorizationHeader)) == 0 {
+		tracing.Fail(span, nil, "missing access token")
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+
+	header := md.Get(authorizationHeader)[0]
+	if !strings.HasPrefix(header, bearerPrefix) {
+		tracing.Fail(span, nil, "bad authorization header")
+		return nil, status.Error(codes.Unauthenticated, "bad authorization header")
+	}
+
+	identity, err := tokens.ParseAccess(strings.TrimPrefix(header, bearerPrefix))
+	if err != nil {
+		tracing.Fail(span, err, "invalid access token")
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return ContextWithIdentity(ctx, identity), nil
+}
+
+func forwardToken(ctx context.Context) context.Context {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(authorizationHeader)) > 0 {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(authorizationHeader)) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, authorizationHeader, md.Get(authorizationHeader)[0])
+}
+
+func makeMethodSet(methods []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		set[method] = struct{}{}
+	}
+	return set
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
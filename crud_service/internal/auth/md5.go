@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"fmt"
+	"regexp"
+)
+
+const md5Name = "md5"
+
+var md5HashRegexp = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// md5Hasher is kept only to verify legacy hashes stored before migration to argon2id.
+// Such hashes are upgraded the next time a user provides the correct password.
+type md5Hasher struct {
+	key string
+}
+
+func newMd5Hasher(key string) Hasher {
+	return &md5Hasher{
+		key: key,
+	}
+}
+
+func (h *md5Hasher) Name() string {
+	return md5Name
+}
+
+func (h *md5Hasher) Hash(password string) (string, error) {
+	return fmt.Sprintf("%x", md5.Sum([]byte(password+h.key))), nil
+}
+
+func (h *md5Hasher) Verify(hash, password string) (bool, error) {
+	otherHash, _ := h.Hash(password)
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(otherHash)) == 1, nil
+}
+
+func (h *md5Hasher) Match(hash string) bool {
+	return md5HashRegexp.MatchString(hash)
+}
+
+func (h *md5Hasher) NeedsRehash(_ string) bool {
+	return true
+}
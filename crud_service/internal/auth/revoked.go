@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	cachePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/cache"
+)
+
+const revokedTokenKeyPrefix = "RevokedToken:"
+
+// cacheRevokedTokens keeps revoked tokens in the cache until they expire. The cache must not evict
+// values before they expire, otherwise revoked tokens could be used again.
+type cacheRevokedTokens struct {
+	cache cachePkg.Interface
+}
+
+func NewCacheRevokedTokens(cache cachePkg.Interface) RevokedTokens {
+	return &cacheRevokedTokens{
+		cache: cache,
+	}
+}
+
+func (r *cacheRevokedTokens) Revoke(ctx context.Context, tokenId string, ttl time.Duration) error {
+	return r.cache.Set(ctx, revokedTokenKeyPrefix+tokenId, []byte("1"), ttl)
+}
+
+func (r *cacheRevokedTokens) RevokeOnce(ctx context.Context, tokenId string, ttl time.Duration) (bool, error) {
+	return r.cache.SetNX(ctx, revokedTokenKeyPrefix+tokenId, []byte("1"), ttl)
+}
+
+func (r *cacheRevokedTokens) IsRevoked(ctx context.Context, tokenId string) (bool, error) {
+	_, err := r.cache.Get(ctx, revokedTokenKeyPrefix+tokenId)
+	if errors.Is(err, cachePkg.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
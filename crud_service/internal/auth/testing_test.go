@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+type authTestFixture struct {
+	password   string
+	legacyHash string
+	user       models.User
+	revoked    *revokedTokensStub
+	tokens     *TokenManager
+}
+
+func setUp(t *testing.T) authTestFixture {
+	t.Parallel()
+
+	var fixture authTestFixture
+	fixture.password = "123456"
+	// md5 hash of the password salted with config.Md5HashKey
+	fixture.legacyHash = "35c04b93cbd80179466d9bf3554e6c3f"
+	fixture.user = models.User{
+		Id:    1,
+		Email: "test01@dummy.com",
+		Name:  "Test Tester",
+		Role:  "Admin",
+	}
+	fixture.revoked = &revokedTokensStub{data: map[string]struct{}{}}
+	fixture.tokens = NewTokenManager("secret", "test", time.Minute, time.Hour, fixture.revoked)
+	return fixture
+}
+
+type revokedTokensStub struct {
+	mu   sync.Mutex
+	data map[string]struct{}
+}
+
+func (r *revokedTokensStub) Revoke(_ context.Context, tokenId string, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.data[tokenId] = struct{}{}
+	return nil
+}
+
+func (r *revokedTokensStub) RevokeOnce(_ context.Context, tokenId string, _ time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.data[tokenId]; ok {
+		return false, nil
+	}
+	r.data[tokenId] = struct{}{}
+	return true, nil
+}
+
+func (r *revokedTokensStub) IsRevoked(_ context.Context, tokenId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.data[tokenId]
+	return ok, nil
+}
@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+const (
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+var ErrRevokedToken = errors.New("token has been revoked")
+
+// Identity is an authenticated caller extracted from the access token
+type Identity struct {
+	Id    uint
+	Email string
+	Role  string
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Email string `json:"email"`
+	Role  string `json:"role"`
+	Type  string `json:"typ"`
+}
+
+// TokenPair is a pair of signed tokens issued to the user on login
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+}
+
+// RevokedTokens keeps ids of refresh tokens which can not be used anymore.
+// Access tokens are short-lived and are not revoked.
+type RevokedTokens interface {
+	Revoke(ctx context.Context, tokenId string, ttl time.Duration) error
+	// RevokeOnce atomically revokes the token if it has not been revoked yet, false means it has been revoked before
+	RevokeOnce(ctx context.Context, tokenId string, ttl time.Duration) (bool, error)
+	IsRevoked(ctx context.Context, tokenId string) (bool, error)
+}
+
+type TokenManager struct {
+	secret     []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	revoked    RevokedTokens
+}
+
+// NewTokenManager creates manager of HMAC-SHA256 signed JWT tokens.
+// Revoked tokens storage is needed only by the service which refreshes and revokes tokens
+// and may be nil for services which only validate access tokens.
+func NewTokenManager(secret, issuer string, accessTTL, refreshTTL time.Duration, revoked RevokedTokens) *TokenManager {
+	return &TokenManager{
+		secret:     []byte(secret),
+		issuer:     issuer,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+		revoked:    revoked,
+	}
+}
+
+func (m *TokenManager) Issue(user models.User) (*TokenPair, error) {
+	accessToken, err := m.sign(user, tokenTypeAccess, m.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := m.sign(user, tokenTypeRefresh, m.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    m.accessTTL,
+	}, nil
+}
+
+func (m *TokenManager) ParseAccess(token string) (*Identity, error) {
+	tokenClaims, err := m.parse(token, tokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+	return identityFromClaims(tokenClaims)
+}
+
+func (m *TokenManager) ParseRefresh(ctx context.Context, token string) (*Identity, error) {
+	tokenClaims, err := m.parse(token, tokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.revoked != nil {
+		revoked, err := m.revoked.IsRevoked(ctx, tokenClaims.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "check token revocation")
+		}
+		if revoked {
+			return nil, ErrRevokedToken
+		}
+	}
+
+	return identityFromClaims(tokenClaims)
+}
+
+// Revoke makes refresh token unusable till its expiration
+func (m *TokenManager) Revoke(ctx context.Context, token string) error {
+	tokenClaims, err := m.parse(token, tokenTypeRefresh)
+	if err != nil {
+		return err
+	}
+	if m.revoked == nil {
+		return errors.New("revoked tokens storage is not configured")
+	}
+
+	ttl := time.Until(tokenClaims.ExpiresAt.Time)
+	if ttl <= 0 {
+		return nil
+	}
+	return m.revoked.Revoke(ctx, tokenClaims.ID, ttl)
+}
+
+// Consume revokes refresh token exchanged for new tokens. ErrRevokedToken is returned if the token
+// has been revoked before, so that concurrent requests can not exchange the same token twice.
+func (m *TokenManager) Consume(ctx context.Context, token string) error {
+	tokenClaims, err := m.parse(token, tokenTypeRefresh)
+	if err != nil {
+		return err
+	}
+	if m.revoked == nil {
+		return errors.New("revoked tokens storage is not configured")
+	}
+
+	revoked, err := m.revoked.RevokeOnce(ctx, tokenClaims.ID, time.Until(tokenClaims.ExpiresAt.Time))
+	if err != nil {
+		return errors.Wrap(err, "revoke token")
+	}
+	if !revoked {
+		return ErrRevokedToken
+	}
+	return nil
+}
+
+func (m *TokenManager) sign(user models.User, tokenType string, ttl time.Duration) (string, error) {
+	tokenId, err := newTokenId()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenId,
+			Issuer:    m.issuer,
+			Subject:   strconv.FormatUint(uint64(user.Id), 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Email: user.Email,
+		Role:  user.Role,
+		Type:  tokenType,
+	})
+
+	signed, err := token.SignedString(m.secret)
+	if err != nil {
+		return "", errors.Wrap(err, "sign token")
+	}
+	return signed, nil
+}
+
+func (m *TokenManager) parse(token string, tokenType string) (*claims, error) {
+	var tokenClaims claims
+	_, err := jwt.ParseWithClaims(token, &tokenClaims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.Errorf("unexpected signing method <%v>", t.Header["alg"])
+		}
+		return m.secret, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	if tokenClaims.Type != tokenType || !tokenClaims.VerifyIssuer(m.issuer, true) {
+		return nil, ErrInvalidToken
+	}
+	return &tokenClaims, nil
+}
+
+func identityFromClaims(tokenClaims *claims) (*Identity, error) {
+	id, err := strconv.ParseUint(tokenClaims.Subject, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, "bad subject")
+	}
+	return &Identity{
+		Id:    uint(id),
+		Email: tokenClaims.Email,
+		Role:  tokenClaims.Role,
+	}, nil
+}
+
+func newTokenId() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generate token id")
+	}
+	return hex.EncodeToString(b), nil
+}
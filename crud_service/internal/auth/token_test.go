@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenManager(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		t.Run("parse access token", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			pair, err := f.tokens.Issue(f.user)
+			require.NoError(t, err)
+
+			// act
+			identity, err := f.tokens.ParseAccess(pair.AccessToken)
+
+			// assert
+			require.NoError(t, err)
+			assert.Equal(t, &Identity{Id: f.user.Id, Email: f.user.Email, Role: f.user.Role}, identity)
+			assert.Equal(t, time.Minute, pair.ExpiresIn)
+		})
+
+		t.Run("parse refresh token", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			pair, err := f.tokens.Issue(f.user)
+			require.NoError(t, err)
+
+			// act
+			identity, err := f.tokens.ParseRefresh(context.Background(), pair.RefreshToken)
+
+			// assert
+			require.NoError(t, err)
+			assert.Equal(t, f.user.Id, identity.Id)
+		})
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("refresh token used as access token", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			pair, err := f.tokens.Issue(f.user)
+			require.NoError(t, err)
+
+			// act
+			_, err = f.tokens.ParseAccess(pair.RefreshToken)
+
+			// assert
+			require.ErrorIs(t, err, ErrInvalidToken)
+		})
+
+		t.Run("wrong secret", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			pair, err := NewTokenManager("other secret", "test", time.Minute, time.Hour, nil).Issue(f.user)
+			require.NoError(t, err)
+
+			// act
+			_, err = f.tokens.ParseAccess(pair.AccessToken)
+
+			// assert
+			require.ErrorIs(t, err, ErrInvalidToken)
+		})
+
+		t.Run("expired token", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			pair, err := NewTokenManager("secret", "test", -time.Minute, time.Hour, nil).Issue(f.user)
+			require.NoError(t, err)
+
+			// act
+			_, err = f.tokens.ParseAccess(pair.AccessToken)
+
+			// assert
+			require.ErrorIs(t, err, ErrInvalidToken)
+		})
+
+		t.Run("revoked refresh token", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			pair, err := f.tokens.Issue(f.user)
+			require.NoError(t, err)
+			require.NoError(t, f.tokens.Revoke(context.Background(), pair.RefreshToken))
+
+			// act
+			_, err = f.tokens.ParseRefresh(context.Background(), pair.RefreshToken)
+
+			// assert
+			require.ErrorIs(t, err, ErrRevokedToken)
+		})
+
+		t.Run("refresh token consumed concurrently", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+			pair, err := f.tokens.Issue(f.user)
+			require.NoError(t, err)
+			const requests = 10
+
+			// act
+			var consumed int32
+			var wg sync.WaitGroup
+			for i := 0; i < requests; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					err := f.tokens.Consume(context.Background(), pair.RefreshToken)
+					if err == nil {
+						atomic.AddInt32(&consumed, 1)
+						return
+					}
+					assert.ErrorIs(t, err, ErrRevokedToken)
+				}()
+			}
+			wg.Wait()
+
+			// assert
+			assert.Equal(t, int32(1), consumed)
+			_, err = f.tokens.ParseRefresh(context.Background(), pair.RefreshToken)
+			require.ErrorIs(t, err, ErrRevokedToken)
+		})
+	})
+}
@@ -3,6 +3,30 @@ package config
 import "time"
 
 const Md5HashKey = "sdskj2HHDjs1"
+
+const (
+	// Password hashing config. Supported algorithms: argon2id, bcrypt
+	PasswordHashAlgorithm = "argon2id"
+
+	Argon2Time    = 1
+	Argon2Memory  = 64 * 1024
+	Argon2Threads = 4
+	Argon2KeyLen  = 32
+	Argon2SaltLen = 16
+
+	BcryptCost = 12
+)
+
+const (
+	// Authentication tokens config
+	TokenSecret     = "kP9s2LmQ7vXz4RtB8nWc"
+	TokenIssuer     = "homework1"
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 7 * 24 * time.Hour
+
+	// Environment variable with access token used by client
+	AccessTokenEnv = "HW_ACCESS_TOKEN"
+)
 const GRPCPortBackend = "8083"
 const GRPCPort = "8082"
 const HTTPPort = "8081"
@@ -22,6 +46,36 @@ const (
 	MaxConns        = 4
 )
 
+// Interval of reloading roles into services' in-process registry
+const RolesRefreshInterval = time.Minute
+
+const (
+	// Soft deleted users are purged permanently after the retention period
+	DeletedUsersRetention = 30 * 24 * time.Hour
+	PurgeInterval         = time.Hour
+)
+
+// Users of atomic UsersAdd are kept in memory until the end of the stream
+const MaxAtomicBatchSize = 1000
+
+const (
+	// Bulk export reads users from storage in pages and sends files in chunks
+	ExportPageSize = 100
+	FileChunkSize  = 32 * 1024
+)
+
+// Page tokens of user lists are encrypted and signed by aescbc cipher of golib with the base64 keys,
+// every token gets a random IV. Tokens are only encoded if EncryptionKey is empty.
+type PageTokenCfg struct {
+	EncryptionKey string
+	SignKey       string
+}
+
+var PageTokenConfig = PageTokenCfg{
+	EncryptionKey: "CgogbPwxVDgOvKD3Ez9JVnJgDJryWkBK35sZDzpWUo0=",
+	SignKey:       "OfbOTik/hI/5i31xXGKTiI7jZ+Q73XFWIDtecNsHa7o=",
+}
+
 const (
 	DefaultRecPerPage   = 5
 	DefaultPageNum      = 1
@@ -30,17 +84,65 @@ const (
 
 var Brokers = []string{"localhost:19091", "localhost:29091", "localhost:39091"}
 
-const (
-	ConsumerGroupUI     = "uiResponseConsuming"
-	ConsumerGroupClient = "clientRequestConsuming"
-)
+// clients read replies without consumer group, see queue.Client
+const ConsumerGroupClient = "clientRequestConsuming"
+
+// offsets of dead letters replayed by the client are committed by the group, so that they are not replayed twice
+const ConsumerGroupDeadLetterReplay = "deadLetterReplay"
 
 const (
 	TopicClientRequest = "client_requests"
 	TopicUIResponse    = "ui_response"
 	TopicUIRequest     = "ui_request"
+	TopicUserEvents    = "user_events"
+	// messages which cannot be processed by consumers of the topics above are moved here
+	TopicDeadLetter = "dead_letters"
 )
 
+// Retry of messages failed by transient errors, e.g. unavailable broker. The backoff is doubled
+// after every attempt up to MaxBackoff, the message is dead-lettered after the last attempt.
+type RetryCfg struct {
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+var QueueRetryConfig = RetryCfg{Attempts: 5, Backoff: 100 * time.Millisecond, MaxBackoff: 5 * time.Second}
+
+// Number of workers processing messages of a partition claimed by a consumer,
+// messages with the same key are processed by the same worker in order
+const QueueWorkers = 8
+
+// Timeout of requests over Kafka without deadline set by the caller
+const QueueRequestTimeout = 10 * time.Second
+
+const (
+	// Events of users' changes are moved from the outbox table to Kafka in batches
+	OutboxRelayInterval  = time.Second
+	OutboxRelayBatchSize = 100
+)
+
+// Cache of the backend service. Supported modes: redis, memory (LRU in the memory of the process)
+// and none. The mode can be changed by the environment variable.
+// NegativeTTL is the lifetime of cached absence of users, TTLJitter is the max part of TTL added to it at random.
+type CacheCfg struct {
+	Mode           string
+	TTL            time.Duration
+	NegativeTTL    time.Duration
+	TTLJitter      float64
+	MemoryCapacity int
+}
+
+var CacheConfig = CacheCfg{
+	Mode:           "redis",
+	TTL:            24 * time.Hour,
+	NegativeTTL:    30 * time.Second,
+	TTLJitter:      0.1,
+	MemoryCapacity: 10000,
+}
+
+const CacheModeEnv = "HW_CACHE_MODE"
+
 type RedisCfg struct {
 	Addr     string
 	Password string
@@ -48,3 +150,30 @@ type RedisCfg struct {
 }
 
 var RedisConfig = RedisCfg{"127.0.0.1:6379", "", 1}
+
+// Tracing of services by OpenTelemetry. Supported exporters: otlp (OTLP over gRPC, e.g. to Jaeger
+// with the OTLP collector enabled), jaeger (Jaeger collector over HTTP), stdout (spans are printed,
+// for local use) and none. The exporter can be changed by the environment variable.
+// SampleRatio is the part of traces sampled by services starting them.
+type TracingCfg struct {
+	Exporter       string
+	OTLPEndpoint   string
+	JaegerEndpoint string
+	SampleRatio    float64
+}
+
+var TracingConfig = TracingCfg{
+	Exporter:       "otlp",
+	OTLPEndpoint:   "localhost:4317",
+	JaegerEndpoint: "http://localhost:14268/api/traces",
+	SampleRatio:    1,
+}
+
+const TracingExporterEnv = "HW_TRACING_EXPORTER"
+
+// Names of services in traces
+const (
+	ServiceNameBot     = "bot"
+	ServiceNameBackend = "backend"
+	ServiceNameClient  = "client"
+)
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
 	commandPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command"
+	sessionPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/session"
 )
 
 type Interface interface {
@@ -16,7 +17,7 @@ type Interface interface {
 	RegisterHandler(cmd commandPkg.Interface)
 }
 
-func MustNew() Interface {
+func MustNew(sessions sessionPkg.Interface) Interface {
 	bot, err := tgbotapi.NewBotAPI(config.ApiKey)
 	if err != nil {
 		log.Panic(errors.Wrap(err, "init tgbot"))
@@ -26,14 +27,16 @@ func MustNew() Interface {
 	log.Printf("Authorized on account %s", bot.Self.UserName)
 
 	return &commander{
-		bot:   bot,
-		route: make(map[string]commandPkg.Interface),
+		bot:      bot,
+		route:    make(map[string]commandPkg.Interface),
+		sessions: sessions,
 	}
 }
 
 type commander struct {
-	bot   *tgbotapi.BotAPI
-	route map[string]commandPkg.Interface
+	bot      *tgbotapi.BotAPI
+	route    map[string]commandPkg.Interface
+	sessions sessionPkg.Interface
 }
 
 // RegisterHandler - not thread-safe
@@ -53,7 +56,9 @@ func (c *commander) Run(ctx context.Context) error {
 		msg := tgbotapi.NewMessage(update.Message.Chat.ID, "")
 		if cmdName := update.Message.Command(); cmdName != "" {
 			if cmd, ok := c.route[cmdName]; ok {
-				msg.Text = cmd.Process(ctx, update.Message.CommandArguments())
+				cmdCtx := commandPkg.WithChatId(ctx, update.Message.Chat.ID)
+				cmdCtx = sessionPkg.ContextWithToken(cmdCtx, c.sessions, update.Message.Chat.ID)
+				msg.Text = cmd.Process(cmdCtx, update.Message.CommandArguments())
 			} else {
 				msg.Text = "Unknown command"
 			}
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
-	"gitlab.ozon.dev/vldem/homework1/internal/auth"
 	commandPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command"
 	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
@@ -48,7 +47,7 @@ func (c *command) Process(ctx context.Context, args string) string {
 		Email:    params[0],
 		Name:     params[1],
 		Role:     params[2],
-		Password: auth.GenHashPassword(params[3]),
+		Password: params[3],
 	})
 	if err != nil {
 		return errors.Wrap(err, msgAddUser).Error()
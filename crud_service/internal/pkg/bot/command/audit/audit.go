@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	commandPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+type command struct {
+	client pb.BackendClient
+}
+
+func New(client pb.BackendClient) commandPkg.Interface {
+	return &command{
+		client: client,
+	}
+}
+
+func (c *command) Name() string {
+	return "audit"
+}
+
+func (c *command) Description() string {
+	return " [<record per page>;<page number>;<user id>]- audit log of user changes"
+}
+
+func (c *command) Process(ctx context.Context, args string) string {
+	result := []string{}
+	params := strings.Split(args, ";")
+	var err error
+	var recPerPage, pageNum, targetId uint64
+	if len(params) >= 2 {
+		recPerPage, err = strconv.ParseUint(params[0], 10, 64)
+		if err != nil {
+			return commandPkg.MsgInvalidArguments
+		}
+		pageNum, err = strconv.ParseUint(params[1], 10, 64)
+		if err != nil {
+			return commandPkg.MsgInvalidArguments
+		}
+	}
+	if len(params) == 3 {
+		targetId, err = strconv.ParseUint(params[2], 10, 64)
+		if err != nil {
+			return commandPkg.MsgInvalidArguments
+		}
+	}
+
+	if recPerPage == 0 {
+		recPerPage = config.DefaultRecPerPage
+	}
+	if pageNum == 0 {
+		pageNum = config.DefaultPageNum
+	}
+
+	events, err := c.client.AuditList(ctx, &pb.BackendAuditListRequest{
+		RecPerPage: &recPerPage,
+		PageNum:    &pageNum,
+		Filter: &pb.BackendAuditListRequest_Filter{
+			TargetId: targetId,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "internal error").Error()
+	}
+	if len(events.GetEvents()) == 0 {
+		return "no events found"
+	}
+
+	for _, event := range events.GetEvents() {
+		actor := event.GetActorEmail()
+		if actor == "" {
+			actor = "system"
+		}
+		result = append(result, fmt.Sprintf("%s: %s user %d by %s %s",
+			event.GetCreatedAt().AsTime().Format(timeLayout), event.GetAction(), event.GetTargetId(), actor, changes(event.GetChanges())))
+	}
+	result = append(result, fmt.Sprintf("page %d of %d, total events: %d", pageNum, events.GetPageCount(), events.GetTotalCount()))
+
+	return strings.Join(result, "\n")
+}
+
+// changes formats changed fields in stable order
+func changes(changes map[string]*pb.BackendAuditListResponse_FieldChange) string {
+	fields := make([]string, 0, len(changes))
+	for field := range changes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	result := make([]string, 0, len(fields))
+	for _, field := range fields {
+		result = append(result, fmt.Sprintf("%s: [%s] -> [%s]", field, changes[field].GetOld(), changes[field].GetNew()))
+	}
+	return strings.Join(result, ", ")
+}
@@ -13,3 +13,15 @@ type Interface interface {
 	Description() string
 	Process(ctx context.Context, args string) string
 }
+
+type chatIdKey struct{}
+
+// WithChatId adds id of telegram chat the command came from to the context
+func WithChatId(ctx context.Context, chatId int64) context.Context {
+	return context.WithValue(ctx, chatIdKey{}, chatId)
+}
+
+func ChatIdFromContext(ctx context.Context) (int64, bool) {
+	chatId, ok := ctx.Value(chatIdKey{}).(int64)
+	return chatId, ok
+}
@@ -28,12 +28,12 @@ func (c *command) Name() string {
 }
 
 func (c *command) Description() string {
-	return "<id>;<password> - delete user"
+	return "<id>[;<password>] - delete user, the password is required to delete own record"
 }
 
 func (c *command) Process(ctx context.Context, args string) string {
 	params := strings.Split(args, ";")
-	if len(params) != 2 {
+	if len(params) != 1 && len(params) != 2 {
 		return commandPkg.MsgInvalidArguments
 	}
 
@@ -41,15 +41,20 @@ func (c *command) Process(ctx context.Context, args string) string {
 	if err := validatorPkg.ValidateUserId(params[0]); err != nil {
 		return errors.Wrap(err, msgDeleteUser).Error()
 	}
-	if err := validatorPkg.ValidatePassword(params[1]); err != nil {
-		return errors.Wrap(err, msgDeleteUser).Error()
+	// admins delete users without password
+	var password string
+	if len(params) == 2 {
+		password = params[1]
+		if err := validatorPkg.ValidatePassword(password); err != nil {
+			return errors.Wrap(err, msgDeleteUser).Error()
+		}
 	}
 
 	id, _ := strconv.ParseUint(params[0], 10, 64)
 
 	_, err := c.client.UserDelete(ctx, &pb.BackendUserDeleteRequest{
 		Id:       id,
-		Password: params[1],
+		Password: password,
 	})
 	if err != nil {
 		return errors.Wrap(err, msgDeleteUser).Error()
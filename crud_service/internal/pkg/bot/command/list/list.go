@@ -91,8 +91,9 @@ func (c *command) Process(ctx context.Context, args string) string {
 	}
 
 	for _, user := range users.GetUsers() {
-		result = append(result, fmt.Sprintf("%d: %s / %s / %s ", user.Id, user.Email, user.Name, user.Role))
+		result = append(result, fmt.Sprintf("%d: %s / %s / %s / version %d", user.Id, user.Email, user.Name, user.Role, user.Version))
 	}
+	result = append(result, fmt.Sprintf("page %d of %d, total users: %d", pageNum, users.GetPageCount(), users.GetTotalCount()))
 
 	return strings.Join(result, "\n")
 }
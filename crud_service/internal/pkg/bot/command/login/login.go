@@ -0,0 +1,68 @@
+package login
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	commandPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command"
+	sessionPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/session"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+)
+
+const msgLogin = "login"
+
+type command struct {
+	client   pb.BackendClient
+	sessions sessionPkg.Interface
+}
+
+func New(client pb.BackendClient, sessions sessionPkg.Interface) commandPkg.Interface {
+	return &command{
+		client:   client,
+		sessions: sessions,
+	}
+}
+
+func (c *command) Name() string {
+	return "login"
+}
+
+func (c *command) Description() string {
+	return "<email>;<password> - log in to manage users"
+}
+
+func (c *command) Process(ctx context.Context, args string) string {
+	params := strings.Split(args, ";")
+	if len(params) != 2 {
+		return commandPkg.MsgInvalidArguments
+	}
+
+	chatId, ok := commandPkg.ChatIdFromContext(ctx)
+	if !ok {
+		return commandPkg.MsgInternalError
+	}
+
+	//validate parameters
+	if err := validatorPkg.ValidateEmail(params[0]); err != nil {
+		return errors.Wrap(err, msgLogin).Error()
+	}
+
+	out, err := c.client.Login(ctx, &pb.BackendLoginRequest{
+		Email:    params[0],
+		Password: params[1],
+	})
+	if err != nil {
+		return errors.Wrap(err, msgLogin).Error()
+	}
+
+	c.sessions.Set(chatId, sessionPkg.Tokens{
+		AccessToken:  out.GetAccessToken(),
+		RefreshToken: out.GetRefreshToken(),
+		ExpiresAt:    time.Now().Add(time.Duration(out.GetExpiresIn()) * time.Second),
+	})
+
+	return "you have been logged in"
+}
@@ -0,0 +1,52 @@
+package logout
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	commandPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command"
+	sessionPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/session"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+)
+
+const msgLogout = "logout"
+
+type command struct {
+	client   pb.BackendClient
+	sessions sessionPkg.Interface
+}
+
+func New(client pb.BackendClient, sessions sessionPkg.Interface) commandPkg.Interface {
+	return &command{
+		client:   client,
+		sessions: sessions,
+	}
+}
+
+func (c *command) Name() string {
+	return "logout"
+}
+
+func (c *command) Description() string {
+	return " - log out"
+}
+
+func (c *command) Process(ctx context.Context, _ string) string {
+	chatId, ok := commandPkg.ChatIdFromContext(ctx)
+	if !ok {
+		return commandPkg.MsgInternalError
+	}
+
+	tokens, ok := c.sessions.Delete(chatId)
+	if !ok {
+		return "you are not logged in"
+	}
+
+	if _, err := c.client.Logout(ctx, &pb.BackendLogoutRequest{
+		RefreshToken: tokens.RefreshToken,
+	}); err != nil {
+		return errors.Wrap(err, msgLogout).Error()
+	}
+
+	return "you have been logged out"
+}
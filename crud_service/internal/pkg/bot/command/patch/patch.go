@@ -0,0 +1,103 @@
+package patch
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	commandPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command"
+	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var msgPatchUser = "patch user"
+
+type command struct {
+	client pb.BackendClient
+}
+
+func New(client pb.BackendClient) commandPkg.Interface {
+	return &command{
+		client: client,
+	}
+}
+
+func (c *command) Name() string {
+	return "patch"
+}
+
+func (c *command) Description() string {
+	return "<id>;<field: email|name|role|password>=<value>[;...][;oldpassword=<old password>][;version=<version>] - change only the given fields of user, old password is required to change email or password"
+}
+
+func (c *command) Process(ctx context.Context, args string) string {
+	params := strings.Split(args, ";")
+	if len(params) < 2 {
+		return commandPkg.MsgInvalidArguments
+	}
+
+	//validate parameters
+	if err := validatorPkg.ValidateUserId(params[0]); err != nil {
+		return errors.Wrap(err, msgPatchUser).Error()
+	}
+	id, _ := strconv.ParseUint(params[0], 10, 64)
+
+	request := &pb.BackendUserPatchRequest{
+		Id:         id,
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	}
+	fields := map[string]string{}
+	for _, param := range params[1:] {
+		field, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return commandPkg.MsgInvalidArguments
+		}
+
+		switch field {
+		case "email":
+			request.Email = value
+		case "name":
+			request.Name = value
+		case "role":
+			request.Role = value
+		case "password":
+			request.Password = value
+		case "oldpassword":
+			request.Oldpassword = value
+			continue
+		case "version":
+			version, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return commandPkg.MsgInvalidArguments
+			}
+			request.Version = &version
+			continue
+		default:
+			return commandPkg.MsgInvalidArguments
+		}
+		if _, ok := fields[field]; !ok {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, field)
+		}
+		fields[field] = value
+	}
+
+	if err := validatorPkg.ValidateUpdateMask(request.UpdateMask.GetPaths()); err != nil {
+		return errors.Wrap(err, msgPatchUser).Error()
+	}
+	if err := validatorPkg.ValidateParameters(fields); err != nil {
+		return errors.Wrap(err, msgPatchUser).Error()
+	}
+
+	if _, err := c.client.UserPatch(ctx, request); err != nil {
+		if status.Code(err) == codes.Aborted {
+			return "user has been changed by someone else, get the new version by /list and try again"
+		}
+		return errors.Wrap(err, msgPatchUser).Error()
+	}
+
+	return "user has been updated"
+}
@@ -6,10 +6,11 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
-	"gitlab.ozon.dev/vldem/homework1/internal/auth"
 	commandPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/bot/command"
 	validatorPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/validator"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var msgUpdateUser = "update user"
@@ -29,12 +30,12 @@ func (c *command) Name() string {
 }
 
 func (c *command) Description() string {
-	return "<id>;<email>;<name>;<role>;<password>;<old password> - update user"
+	return "<id>;<email>;<name>;<role>;<password>[;<old password>[;<version>]] - update user, the old password is required to update own record and may be left empty by admins, the version shown by /list protects from overwriting changes of others"
 }
 
 func (c *command) Process(ctx context.Context, args string) string {
 	params := strings.Split(args, ";")
-	if len(params) != 6 {
+	if len(params) < 5 || len(params) > 7 {
 		return commandPkg.MsgInvalidArguments
 	}
 
@@ -42,19 +43,42 @@ func (c *command) Process(ctx context.Context, args string) string {
 	if err := validatorPkg.ValidateUserId(params[0]); err != nil {
 		return errors.Wrap(err, msgUpdateUser).Error()
 	}
-	if err := validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate(params[1 : len(params)-1])); err != nil {
+	if err := validatorPkg.ValidateParameters(validatorPkg.MakeParametersToValidate(params[1:5])); err != nil {
 		return errors.Wrap(err, msgUpdateUser).Error()
 	}
 
+	// admins update users without the old password
+	var oldPassword string
+	if len(params) > 5 && params[5] != "" {
+		oldPassword = params[5]
+		if err := validatorPkg.ValidatePassword(oldPassword); err != nil {
+			return errors.Wrap(err, msgUpdateUser).Error()
+		}
+	}
+
 	id, _ := strconv.ParseUint(params[0], 10, 64)
 
+	var version *uint64
+	if len(params) == 7 {
+		value, err := strconv.ParseUint(params[6], 10, 64)
+		if err != nil {
+			return commandPkg.MsgInvalidArguments
+		}
+		version = &value
+	}
+
 	if _, err := c.client.UserUpdate(ctx, &pb.BackendUserUpdateRequest{
-		Id:       id,
-		Email:    params[1],
-		Name:     params[2],
-		Role:     params[3],
-		Password: auth.GenHashPassword(params[4]),
+		Id:          id,
+		Email:       params[1],
+		Name:        params[2],
+		Role:        params[3],
+		Password:    params[4],
+		Oldpassword: oldPassword,
+		Version:     version,
 	}); err != nil {
+		if status.Code(err) == codes.Aborted {
+			return "user has been changed by someone else, get the new version by /list and try again"
+		}
 		return errors.Wrap(err, msgUpdateUser).Error()
 	}
 
@@ -0,0 +1,93 @@
+// This package keeps access tokens of users logged in the telegram bot
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+)
+
+// refreshGap is time before expiration when access token is refreshed in advance
+const refreshGap = 30 * time.Second
+
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+type Interface interface {
+	Set(chatId int64, tokens Tokens)
+	Delete(chatId int64) (Tokens, bool)
+	AccessToken(ctx context.Context, chatId int64) (string, bool)
+}
+
+func New(client pb.BackendClient) Interface {
+	return &sessions{
+		client: client,
+		data:   map[int64]Tokens{},
+	}
+}
+
+type sessions struct {
+	mu     sync.Mutex
+	client pb.BackendClient
+	data   map[int64]Tokens
+}
+
+func (s *sessions) Set(chatId int64, tokens Tokens) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[chatId] = tokens
+}
+
+func (s *sessions) Delete(chatId int64) (Tokens, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens, ok := s.data[chatId]
+	delete(s.data, chatId)
+	return tokens, ok
+}
+
+// AccessToken returns valid access token of the chat refreshing it if it's about to expire.
+// The session is dropped if the token cannot be refreshed.
+func (s *sessions) AccessToken(ctx context.Context, chatId int64) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, ok := s.data[chatId]
+	if !ok {
+		return "", false
+	}
+	if time.Until(tokens.ExpiresAt) > refreshGap {
+		return tokens.AccessToken, true
+	}
+
+	out, err := s.client.Refresh(ctx, &pb.BackendRefreshRequest{
+		RefreshToken: tokens.RefreshToken,
+	})
+	if err != nil {
+		delete(s.data, chatId)
+		return "", false
+	}
+
+	tokens = Tokens{
+		AccessToken:  out.GetAccessToken(),
+		RefreshToken: out.GetRefreshToken(),
+		ExpiresAt:    time.Now().Add(time.Duration(out.GetExpiresIn()) * time.Second),
+	}
+	s.data[chatId] = tokens
+	return tokens.AccessToken, true
+}
+
+// ContextWithToken adds access token of the chat to the outgoing context if the chat is logged in
+func ContextWithToken(ctx context.Context, s Interface, chatId int64) context.Context {
+	token, ok := s.AccessToken(ctx, chatId)
+	if !ok {
+		return ctx
+	}
+	return auth.ContextWithToken(ctx, token)
+}
@@ -0,0 +1,67 @@
+// Package broker defines the message broker used by services to communicate over topics,
+// it is implemented by Kafka in production and in memory in tests.
+package broker
+
+import (
+	"context"
+)
+
+// AnyPartition lets the broker choose the partition of the published message by its key,
+// messages with the same key get into the same partition
+const AnyPartition int32 = -1
+
+// Message is the message of the topic, Partition and Offset are set by the broker on publishing
+type Message struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   map[string]string
+}
+
+// Publisher sends messages to topics
+type Publisher interface {
+	// Publish sends the message to the partition of the message or, if it is AnyPartition,
+	// to the partition chosen by the key. It returns after the broker has stored the message.
+	// The span context of ctx is added to headers of the stored message.
+	Publish(ctx context.Context, msg *Message) error
+}
+
+// Claim is the partition consumed by the member of the consumer group
+type Claim interface {
+	// Context is done when the claim is released, e.g. by rebalance of the group
+	Context() context.Context
+	Topic() string
+	Partition() int32
+	// Messages returns messages following the last acked one, the channel is closed when the claim is released
+	Messages() <-chan *Message
+	// Ack marks the message and all messages before it as consumed by the group
+	Ack(msg *Message)
+}
+
+// ClaimHandler consumes messages of the claim, it must return when the claim is released.
+// When the handler returns, claims of the member are released and assigned again.
+type ClaimHandler func(claim Claim) error
+
+// Subscriber consumes topics by consumer groups
+type Subscriber interface {
+	// Subscribe consumes topics as a member of the group until the context is done. Partitions of topics
+	// are shared by members of the group, the handler is called concurrently for every claimed partition.
+	Subscribe(ctx context.Context, group string, topics []string, handler ClaimHandler) error
+}
+
+// PartitionReader reads partitions without consumer group
+type PartitionReader interface {
+	Partitions(topic string) ([]int32, error)
+	// ReadPartition returns messages published to the partition after the call,
+	// the channel is closed when the context is done
+	ReadPartition(ctx context.Context, topic string, partition int32) (<-chan *Message, error)
+}
+
+type Broker interface {
+	Publisher
+	Subscriber
+	PartitionReader
+	Close() error
+}
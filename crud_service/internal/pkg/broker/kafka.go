@@ -0,0 +1,254 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+)
+
+// Kafka is the broker over Kafka cluster
+type Kafka struct {
+	brokers  []string
+	cfg      *sarama.Config
+	client   sarama.Client
+	producer sarama.SyncProducer
+	consumer sarama.Consumer
+}
+
+// NewKafka connects to the cluster, the producer of cfg is set up to return successes
+// and to send messages to the partitions requested by publishers
+func NewKafka(brokers []string, cfg *sarama.Config) (*Kafka, error) {
+	cfg.Producer.Return.Successes = true
+	cfg.Producer.Partitioner = newPartitioner
+
+	client, err := sarama.NewClient(brokers, cfg)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		producer.Close()
+		client.Close()
+		return nil, err
+	}
+	return &Kafka{
+		brokers:  brokers,
+		cfg:      cfg,
+		client:   client,
+		producer: producer,
+		consumer: consumer,
+	}, nil
+}
+
+func (k *Kafka) Publish(ctx context.Context, msg *Message) error {
+	traced := *msg
+	traced.Headers = withTrace(ctx, msg.Headers)
+	_, _, err := k.producer.SendMessage(producerMessage(&traced))
+	if err != nil {
+		return errors.Wrapf(err, "sending message to %v topic", msg.Topic)
+	}
+	return nil
+}
+
+func (k *Kafka) Subscribe(ctx context.Context, group string, topics []string, handler ClaimHandler) error {
+	// consumer groups cannot share the client
+	consumerGroup, err := sarama.NewConsumerGroup(k.brokers, group, k.cfg)
+	if err != nil {
+		return err
+	}
+	defer consumerGroup.Close()
+
+	// Consume returns on rebalance, so it is called again until the context is done
+	for ctx.Err() == nil {
+		if err := consumerGroup.Consume(ctx, topics, &groupHandler{handler: handler}); err != nil {
+			loggerPkg.Logger.Log.Error(fmt.Sprintf("on consume: %v", err))
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second * 10):
+			}
+		}
+	}
+	return nil
+}
+
+func (k *Kafka) Partitions(topic string) ([]int32, error) {
+	return k.client.Partitions(topic)
+}
+
+func (k *Kafka) ReadPartition(ctx context.Context, topic string, partition int32) (<-chan *Message, error) {
+	partitionConsumer, err := k.consumer.ConsumePartition(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "consuming partition %v of %v topic", partition, topic)
+	}
+
+	messages := make(chan *Message)
+	go func() {
+		defer close(messages)
+		defer partitionConsumer.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-partitionConsumer.Messages():
+				if !ok {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case messages <- message(msg):
+				}
+			}
+		}
+	}()
+	return messages, nil
+}
+
+func (k *Kafka) Close() error {
+	k.consumer.Close()
+	k.producer.Close()
+	return k.client.Close()
+}
+
+// groupHandler passes claims of the consumer group session to the handler
+type groupHandler struct {
+	handler ClaimHandler
+}
+
+func (h *groupHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *groupHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	c := &kafkaClaim{
+		session:  session,
+		claim:    claim,
+		messages: make(chan *Message),
+		done:     make(chan struct{}),
+	}
+	go c.run()
+	defer close(c.done)
+	return h.handler(c)
+}
+
+type kafkaClaim struct {
+	session  sarama.ConsumerGroupSession
+	claim    sarama.ConsumerGroupClaim
+	messages chan *Message
+	// done is closed when the handler returns
+	done chan struct{}
+}
+
+func (c *kafkaClaim) run() {
+	defer close(c.messages)
+	for msg := range c.claim.Messages() {
+		select {
+		case <-c.done:
+			return
+		case c.messages <- message(msg):
+		}
+	}
+}
+
+func (c *kafkaClaim) Context() context.Context {
+	return c.session.Context()
+}
+
+func (c *kafkaClaim) Topic() string {
+	return c.claim.Topic()
+}
+
+func (c *kafkaClaim) Partition() int32 {
+	return c.claim.Partition()
+}
+
+func (c *kafkaClaim) Messages() <-chan *Message {
+	return c.messages
+}
+
+func (c *kafkaClaim) Ack(msg *Message) {
+	c.session.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, "")
+}
+
+// explicitPartition marks messages sent to the partition requested by the publisher
+type explicitPartition struct{}
+
+func newPartitioner(topic string) sarama.Partitioner {
+	return &partitioner{hash: sarama.NewHashPartitioner(topic)}
+}
+
+// partitioner sends messages to the requested partitions, other messages are partitioned by key
+type partitioner struct {
+	hash sarama.Partitioner
+}
+
+func (p *partitioner) Partition(msg *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	if _, ok := msg.Metadata.(explicitPartition); ok {
+		if msg.Partition < 0 || msg.Partition >= numPartitions {
+			return -1, sarama.ErrInvalidPartition
+		}
+		return msg.Partition, nil
+	}
+	return p.hash.Partition(msg, numPartitions)
+}
+
+func (p *partitioner) RequiresConsistency() bool {
+	return true
+}
+
+func producerMessage(msg *Message) *sarama.ProducerMessage {
+	result := &sarama.ProducerMessage{
+		Topic: msg.Topic,
+		Value: sarama.ByteEncoder(msg.Value),
+	}
+	// messages without key are spread over partitions
+	if len(msg.Key) > 0 {
+		result.Key = sarama.ByteEncoder(msg.Key)
+	}
+	if msg.Partition != AnyPartition {
+		result.Partition = msg.Partition
+		result.Metadata = explicitPartition{}
+	}
+
+	keys := make([]string, 0, len(msg.Headers))
+	for key := range msg.Headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		result.Headers = append(result.Headers, sarama.RecordHeader{
+			Key:   []byte(key),
+			Value: []byte(msg.Headers[key]),
+		})
+	}
+	return result
+}
+
+func message(msg *sarama.ConsumerMessage) *Message {
+	result := &Message{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   make(map[string]string, len(msg.Headers)),
+	}
+	for _, header := range msg.Headers {
+		result.Headers[string(header.Key)] = string(header.Value)
+	}
+	return result
+}
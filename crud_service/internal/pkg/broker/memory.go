@@ -0,0 +1,352 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+)
+
+// Memory is the broker keeping messages in memory, so that services communicating over topics
+// are tested in one process. Topics are created on first use with the same number of partitions.
+// Partitions of topics are shared by members of the group as by Kafka, the group is rebalanced
+// when members join or leave it.
+type Memory struct {
+	partitions int32
+
+	mu     sync.Mutex
+	topics map[string]*memoryTopic
+	groups map[string]*memoryGroup
+}
+
+type memoryTopic struct {
+	partitions []*memoryPartition
+	// messages without key are spread over partitions by turns
+	next int32
+}
+
+type memoryPartition struct {
+	messages []*Message
+	// published is closed and replaced when the message is published, so that readers wait on it
+	published chan struct{}
+}
+
+type topicPartition struct {
+	topic     string
+	partition int32
+}
+
+type memoryGroup struct {
+	// offsets of the messages following the acked ones
+	offsets map[topicPartition]int64
+	members []*memoryMember
+	// rebalance is closed and replaced when members join or leave the group
+	rebalance chan struct{}
+}
+
+type memoryMember struct {
+	topics []string
+}
+
+// NewMemory returns the broker creating topics with the number of partitions
+func NewMemory(partitions int32) *Memory {
+	if partitions < 1 {
+		partitions = 1
+	}
+	return &Memory{
+		partitions: partitions,
+		topics:     make(map[string]*memoryTopic),
+		groups:     make(map[string]*memoryGroup),
+	}
+}
+
+func (m *Memory) Publish(ctx context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t := m.topic(msg.Topic)
+	partition := msg.Partition
+	switch {
+	case partition == AnyPartition && len(msg.Key) > 0:
+		h := fnv.New32a()
+		h.Write(msg.Key)
+		partition = int32(h.Sum32() % uint32(len(t.partitions)))
+	case partition == AnyPartition:
+		partition = t.next
+		t.next = (t.next + 1) % int32(len(t.partitions))
+	case partition < 0 || partition >= int32(len(t.partitions)):
+		return errors.Errorf("partition %v of %v topic does not exist", partition, msg.Topic)
+	}
+
+	p := t.partitions[partition]
+	published := &Message{
+		Topic:     msg.Topic,
+		Partition: partition,
+		Offset:    int64(len(p.messages)),
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   withTrace(ctx, msg.Headers),
+	}
+	p.messages = append(p.messages, published)
+	close(p.published)
+	p.published = make(chan struct{})
+	return nil
+}
+
+func (m *Memory) Subscribe(ctx context.Context, group string, topics []string, handler ClaimHandler) error {
+	g, member := m.join(group, topics)
+	defer m.leave(g, member)
+
+	for ctx.Err() == nil {
+		claims, rebalance := m.assign(g, member)
+		if err := m.session(ctx, g, claims, rebalance, handler); err != nil {
+			loggerPkg.Logger.Log.Error(fmt.Sprintf("on consume: %v", err))
+			select {
+			case <-ctx.Done():
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Memory) Partitions(topic string) ([]int32, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	partitions := make([]int32, len(m.topic(topic).partitions))
+	for n := range partitions {
+		partitions[n] = int32(n)
+	}
+	return partitions, nil
+}
+
+func (m *Memory) ReadPartition(ctx context.Context, topic string, partition int32) (<-chan *Message, error) {
+	m.mu.Lock()
+	t := m.topic(topic)
+	if partition < 0 || partition >= int32(len(t.partitions)) {
+		m.mu.Unlock()
+		return nil, errors.Errorf("partition %v of %v topic does not exist", partition, topic)
+	}
+	offset := int64(len(t.partitions[partition].messages))
+	m.mu.Unlock()
+
+	messages := make(chan *Message)
+	go m.read(ctx, topicPartition{topic: topic, partition: partition}, offset, messages)
+	return messages, nil
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+// Messages returns all messages published to the topic, ordered by partitions and offsets
+func (m *Memory) Messages(topic string) []*Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var messages []*Message
+	for _, p := range m.topic(topic).partitions {
+		messages = append(messages, p.messages...)
+	}
+	return messages
+}
+
+// topic returns the topic creating it on first use, m.mu must be locked
+func (m *Memory) topic(name string) *memoryTopic {
+	t, ok := m.topics[name]
+	if !ok {
+		t = &memoryTopic{partitions: make([]*memoryPartition, m.partitions)}
+		for n := range t.partitions {
+			t.partitions[n] = &memoryPartition{published: make(chan struct{})}
+		}
+		m.topics[name] = t
+	}
+	return t
+}
+
+// read sends messages of the partition starting from the offset until the context is done
+func (m *Memory) read(ctx context.Context, tp topicPartition, offset int64, messages chan<- *Message) {
+	defer close(messages)
+	for {
+		m.mu.Lock()
+		p := m.topic(tp.topic).partitions[tp.partition]
+		var msg *Message
+		if offset < int64(len(p.messages)) {
+			msg = p.messages[offset]
+		}
+		published := p.published
+		m.mu.Unlock()
+
+		if msg == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-published:
+				continue
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case messages <- msg:
+			offset++
+		}
+	}
+}
+
+func (m *Memory) join(group string, topics []string) (*memoryGroup, *memoryMember) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g, ok := m.groups[group]
+	if !ok {
+		g = &memoryGroup{
+			offsets:   make(map[topicPartition]int64),
+			rebalance: make(chan struct{}),
+		}
+		m.groups[group] = g
+	}
+	member := &memoryMember{topics: topics}
+	g.members = append(g.members, member)
+	g.rebalanced()
+	return g, member
+}
+
+func (m *Memory) leave(g *memoryGroup, member *memoryMember) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for n := range g.members {
+		if g.members[n] == member {
+			g.members = append(g.members[:n], g.members[n+1:]...)
+			break
+		}
+	}
+	g.rebalanced()
+}
+
+// rebalanced notifies members of the group about the change of members, m.mu must be locked
+func (g *memoryGroup) rebalanced() {
+	close(g.rebalance)
+	g.rebalance = make(chan struct{})
+}
+
+// assign returns partitions claimed by the member, partitions of every topic are assigned by turns
+// to the members subscribed to it in order of joining
+func (m *Memory) assign(g *memoryGroup, member *memoryMember) ([]topicPartition, <-chan struct{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var claims []topicPartition
+	for _, topic := range member.topics {
+		var subscribed []*memoryMember
+		for _, other := range g.members {
+			for _, t := range other.topics {
+				if t == topic {
+					subscribed = append(subscribed, other)
+					break
+				}
+			}
+		}
+		for partition := range m.topic(topic).partitions {
+			if subscribed[partition%len(subscribed)] == member {
+				claims = append(claims, topicPartition{topic: topic, partition: int32(partition)})
+			}
+		}
+	}
+	sort.Slice(claims, func(i, j int) bool {
+		if claims[i].topic != claims[j].topic {
+			return claims[i].topic < claims[j].topic
+		}
+		return claims[i].partition < claims[j].partition
+	})
+	return claims, g.rebalance
+}
+
+// session runs the handler for every claim until the group is rebalanced, the context is done
+// or any handler returns, the first error of handlers is returned
+func (m *Memory) session(ctx context.Context, g *memoryGroup, claims []topicPartition, rebalance <-chan struct{}, handler ClaimHandler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-rebalance:
+			cancel()
+		}
+	}()
+
+	if len(claims) == 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	var (
+		wg      sync.WaitGroup
+		errOnce sync.Once
+		failure error
+	)
+	for _, tp := range claims {
+		tp := tp
+		m.mu.Lock()
+		offset := g.offsets[tp]
+		m.mu.Unlock()
+
+		messages := make(chan *Message)
+		c := &memoryClaim{ctx: ctx, broker: m, group: g, tp: tp, messages: messages}
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			m.read(ctx, tp, offset, messages)
+		}()
+		go func() {
+			defer wg.Done()
+			// the session ends when any handler returns, as it does in Kafka
+			defer cancel()
+			if err := handler(c); err != nil {
+				errOnce.Do(func() { failure = err })
+			}
+		}()
+	}
+	wg.Wait()
+	return failure
+}
+
+type memoryClaim struct {
+	ctx      context.Context
+	broker   *Memory
+	group    *memoryGroup
+	tp       topicPartition
+	messages <-chan *Message
+}
+
+func (c *memoryClaim) Context() context.Context {
+	return c.ctx
+}
+
+func (c *memoryClaim) Topic() string {
+	return c.tp.topic
+}
+
+func (c *memoryClaim) Partition() int32 {
+	return c.tp.partition
+}
+
+func (c *memoryClaim) Messages() <-chan *Message {
+	return c.messages
+}
+
+func (c *memoryClaim) Ack(msg *Message) {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+
+	if msg.Offset+1 > c.group.offsets[c.tp] {
+		c.group.offsets[c.tp] = msg.Offset + 1
+	}
+}
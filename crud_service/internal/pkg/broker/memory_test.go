@@ -0,0 +1,168 @@
+package broker
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+func TestMemoryPublish(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		b := NewMemory(4)
+
+		// act
+		for _, key := range []string{"1", "2", "1", "1"} {
+			require.NoError(t, b.Publish(ctx, &Message{Topic: "events", Partition: AnyPartition, Key: []byte(key)}))
+		}
+		require.NoError(t, b.Publish(ctx, &Message{Topic: "events", Partition: 3, Value: []byte("explicit")}))
+
+		// assert
+		partitions := make(map[string]int32)
+		for _, msg := range b.Messages("events") {
+			if len(msg.Key) == 0 {
+				assert.Equal(t, int32(3), msg.Partition)
+				continue
+			}
+			if partition, ok := partitions[string(msg.Key)]; ok {
+				// messages with the same key keep their order in one partition
+				assert.Equal(t, partition, msg.Partition)
+			}
+			partitions[string(msg.Key)] = msg.Partition
+		}
+		assert.Len(t, b.Messages("events"), 5)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		b := NewMemory(4)
+
+		// act
+		err := b.Publish(ctx, &Message{Topic: "events", Partition: 4})
+
+		// assert
+		assert.EqualError(t, err, "partition 4 of events topic does not exist")
+	})
+}
+
+func TestMemorySubscribe(t *testing.T) {
+	loggerPkg.Logger.Log = zap.NewNop()
+
+	t.Run("members share partitions", func(t *testing.T) {
+		// arrange
+		b := NewMemory(4)
+		ctx, cancel := context.WithCancel(context.Background())
+		var (
+			wg       sync.WaitGroup
+			mu       sync.Mutex
+			received = make(map[int32]int)
+		)
+		for member := 0; member < 2; member++ {
+			member := member
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = b.Subscribe(ctx, "group", []string{"events"}, func(claim Claim) error {
+					for msg := range claim.Messages() {
+						mu.Lock()
+						received[msg.Partition] = member
+						mu.Unlock()
+						claim.Ack(msg)
+					}
+					return nil
+				})
+			}()
+		}
+
+		assert.Eventually(t, func() bool {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			return len(b.groups["group"].members) == 2
+		}, time.Second, time.Millisecond)
+
+		// act
+		for partition := int32(0); partition < 4; partition++ {
+			require.NoError(t, b.Publish(ctx, &Message{Topic: "events", Partition: partition}))
+		}
+
+		// assert
+		assert.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(received) == 4
+		}, time.Second, time.Millisecond)
+		cancel()
+		wg.Wait()
+		// partitions are assigned to members by turns
+		assert.Equal(t, received[0], received[2])
+		assert.Equal(t, received[1], received[3])
+		assert.NotEqual(t, received[0], received[1])
+	})
+
+	t.Run("unacked message is redelivered", func(t *testing.T) {
+		// arrange
+		b := NewMemory(1)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		require.NoError(t, b.Publish(ctx, &Message{Topic: "events", Partition: AnyPartition, Value: []byte("1")}))
+		delivered := make(chan *Message, 2)
+		var attempts int
+
+		// act
+		go func() {
+			_ = b.Subscribe(ctx, "group", []string{"events"}, func(claim Claim) error {
+				for msg := range claim.Messages() {
+					delivered <- msg
+					attempts++
+					if attempts == 1 {
+						return errors.New("handler failed")
+					}
+					claim.Ack(msg)
+				}
+				return nil
+			})
+		}()
+
+		// assert
+		for n := 0; n < 2; n++ {
+			select {
+			case msg := <-delivered:
+				assert.Equal(t, int64(0), msg.Offset)
+			case <-time.After(time.Second):
+				t.Fatal("message is not delivered")
+			}
+		}
+	})
+}
+
+func TestMemoryReadPartition(t *testing.T) {
+	// arrange
+	b := NewMemory(2)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, b.Publish(ctx, &Message{Topic: "replies", Partition: 1, Value: []byte("old")}))
+	messages, err := b.ReadPartition(ctx, "replies", 1)
+	require.NoError(t, err)
+
+	// act
+	require.NoError(t, b.Publish(ctx, &Message{Topic: "replies", Partition: 0, Value: []byte("other")}))
+	require.NoError(t, b.Publish(ctx, &Message{Topic: "replies", Partition: 1, Value: []byte("new")}))
+
+	// assert
+	select {
+	case msg := <-messages:
+		// messages published before reading and to other partitions are not read
+		assert.Equal(t, []byte("new"), msg.Value)
+	case <-time.After(time.Second):
+		t.Fatal("message is not read")
+	}
+}
@@ -0,0 +1,38 @@
+package broker
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/pkg/broker")
+
+// withTrace returns the copy of headers with the trace context of ctx, so that consumers
+// of the message continue the trace of the publisher
+func withTrace(ctx context.Context, headers map[string]string) map[string]string {
+	result := make(map[string]string, len(headers))
+	for key, value := range headers {
+		result[key] = value
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(result))
+	return result
+}
+
+// StartSpan starts the span of processing the message, it is the child of the span which published
+// the message or the root span of the trace if the message has no trace context
+func StartSpan(ctx context.Context, name string, msg *Message) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(msg.Headers))
+	return tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingDestinationKindTopic,
+			semconv.MessagingDestinationKey.String(msg.Topic),
+			semconv.MessagingOperationProcess,
+			semconv.MessagingKafkaPartitionKey.Int64(int64(msg.Partition)),
+		),
+	)
+}
@@ -0,0 +1,54 @@
+package broker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestStartSpan(t *testing.T) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Run("continues trace of publisher", func(t *testing.T) {
+		// arrange
+		b := NewMemory(1)
+		ctx, parent := otel.Tracer("test").Start(context.Background(), "publish")
+		require.NoError(t, b.Publish(ctx, &Message{Topic: "events", Partition: AnyPartition}))
+		parent.End()
+
+		// act
+		ctx, span := StartSpan(context.Background(), "consume", b.Messages("events")[0])
+		span.End()
+
+		// assert
+		child := span.(sdktrace.ReadOnlySpan)
+		assert.Equal(t, parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+		assert.Equal(t, parent.SpanContext().SpanID(), child.Parent().SpanID())
+		assert.True(t, child.Parent().IsRemote())
+		assert.Equal(t, trace.SpanKindConsumer, child.SpanKind())
+		assert.Contains(t, child.Attributes(), semconv.MessagingDestinationKey.String("events"))
+		assert.Equal(t, span, trace.SpanFromContext(ctx))
+	})
+
+	t.Run("message without trace context", func(t *testing.T) {
+		// arrange
+		b := NewMemory(1)
+		require.NoError(t, b.Publish(context.Background(), &Message{Topic: "events", Partition: AnyPartition}))
+
+		// act
+		_, span := StartSpan(context.Background(), "consume", b.Messages("events")[0])
+		span.End()
+
+		// assert
+		assert.Empty(t, b.Messages("events")[0].Headers)
+		assert.False(t, span.(sdktrace.ReadOnlySpan).Parent().IsValid())
+	})
+}
@@ -0,0 +1,73 @@
+//go:generate mockgen -source=./cache.go -destination=./mocks/cache.go -package=mock_cache
+
+// This package caches data of the services. Values are kept in Redis, in the memory of the process
+// or not kept at all, so that the services can run without Redis.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+)
+
+// Modes of the cache selected by the config
+const (
+	ModeRedis  = "redis"
+	ModeMemory = "memory"
+	ModeNone   = "none"
+)
+
+var ErrNotFound = errors.New("key is not found in cache")
+var ErrUnknownMode = errors.New("unknown cache mode")
+
+type Interface interface {
+	// Get returns ErrNotFound if the key is absent or expired
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set keeps the value until the ttl passes, zero ttl means that the value does not expire
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// SetNX atomically sets the value only if the key is absent or expired, false means that the key exists
+	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	Delete(ctx context.Context, keys ...string) error
+	DeleteByPrefix(ctx context.Context, prefix string) error
+	// Incr atomically increments the integer value of the key, absent key is counted from zero.
+	// The value keeps its ttl, the key created by Incr does not expire.
+	Incr(ctx context.Context, key string) (int64, error)
+}
+
+// New returns the cache of the given mode, the client is used only by the redis mode.
+// The memory mode keeps at most capacity values.
+func New(mode string, client *redis.Client, capacity int) (Interface, error) {
+	switch mode {
+	case ModeRedis:
+		return NewRedis(client), nil
+	case ModeMemory:
+		return NewLRU(capacity), nil
+	case ModeNone:
+		return NewNoop(), nil
+	}
+	return nil, errors.Wrapf(ErrUnknownMode, "mode: [%s]", mode)
+}
+
+// GetJSON reads the cached value into v
+func GetJSON(ctx context.Context, c Interface, key string, v interface{}) error {
+	data, err := c.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errors.Wrapf(err, "cache.GetJSON key: [%s]", key)
+	}
+	return nil
+}
+
+// SetJSON caches v encoded to JSON
+func SetJSON(ctx context.Context, c Interface, key string, v interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrapf(err, "cache.SetJSON key: [%s]", key)
+	}
+	return c.Set(ctx, key, data, ttl)
+}
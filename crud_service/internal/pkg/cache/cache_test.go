@@ -0,0 +1,429 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("get", func(t *testing.T) {
+		// arrange
+		cache := NewLRU(2)
+		require.NoError(t, cache.Set(ctx, "a", []byte("1"), time.Minute))
+
+		// act
+		value, err := cache.Get(ctx, "a")
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, []byte("1"), value)
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		// arrange
+		cache := NewLRU(2)
+		require.NoError(t, cache.Set(ctx, "a", []byte("1"), 0))
+		require.NoError(t, cache.Set(ctx, "b", []byte("2"), 0))
+		_, err := cache.Get(ctx, "a")
+		require.NoError(t, err)
+
+		// act
+		require.NoError(t, cache.Set(ctx, "c", []byte("3"), 0))
+
+		// assert
+		_, err = cache.Get(ctx, "b")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = cache.Get(ctx, "a")
+		assert.NoError(t, err)
+		_, err = cache.Get(ctx, "c")
+		assert.NoError(t, err)
+	})
+
+	t.Run("expires", func(t *testing.T) {
+		// arrange
+		now := &clock{now: time.Date(2022, 11, 15, 12, 0, 0, 0, time.UTC)}
+		cache := newLRU(0, now.Now)
+		require.NoError(t, cache.Set(ctx, "a", []byte("1"), time.Minute))
+		require.NoError(t, cache.Set(ctx, "b", []byte("2"), time.Hour))
+
+		// act
+		now.now = now.now.Add(time.Minute)
+
+		// assert
+		_, err := cache.Get(ctx, "a")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = cache.Get(ctx, "b")
+		assert.NoError(t, err)
+	})
+
+	t.Run("sweeps expired on set", func(t *testing.T) {
+		// arrange
+		now := &clock{now: time.Date(2022, 11, 15, 12, 0, 0, 0, time.UTC)}
+		cache := newLRU(0, now.Now)
+		require.NoError(t, cache.Set(ctx, "a", []byte("1"), time.Minute))
+		now.now = now.now.Add(time.Hour)
+
+		// act
+		require.NoError(t, cache.Set(ctx, "b", []byte("2"), 0))
+
+		// assert
+		assert.Equal(t, 1, cache.order.Len())
+		assert.Len(t, cache.entries, 1)
+	})
+
+	t.Run("delete by prefix", func(t *testing.T) {
+		// arrange
+		cache := NewLRU(0)
+		for _, key := range []string{"UserList:1", "UserList:2", "UserGet:1"} {
+			require.NoError(t, cache.Set(ctx, key, []byte("1"), 0))
+		}
+
+		// act
+		err := cache.DeleteByPrefix(ctx, "UserList:")
+
+		// assert
+		require.NoError(t, err)
+		_, err = cache.Get(ctx, "UserList:1")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = cache.Get(ctx, "UserList:2")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = cache.Get(ctx, "UserGet:1")
+		assert.NoError(t, err)
+	})
+}
+
+func TestIncr(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lru", func(t *testing.T) {
+		// arrange
+		cache := NewLRU(0)
+		_, err := cache.Incr(ctx, "counter")
+		require.NoError(t, err)
+
+		// act
+		value, err := cache.Incr(ctx, "counter")
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), value)
+		data, err := cache.Get(ctx, "counter")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("2"), data)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		// arrange
+		now := &clock{now: time.Date(2022, 11, 15, 12, 0, 0, 0, time.UTC)}
+		cache := newLRU(0, now.Now)
+		require.NoError(t, cache.Set(ctx, "counter", []byte("5"), time.Minute))
+		now.now = now.now.Add(time.Minute)
+
+		// act
+		value, err := cache.Incr(ctx, "counter")
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), value)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		cache := NewLRU(0)
+		require.NoError(t, cache.Set(ctx, "counter", []byte("abc"), 0))
+
+		// act
+		_, err := cache.Incr(ctx, "counter")
+
+		// assert
+		assert.Error(t, err)
+	})
+}
+
+func TestSetNX(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("absent key", func(t *testing.T) {
+		// arrange
+		cache := NewLRU(0)
+
+		// act
+		ok, err := cache.SetNX(ctx, "token", []byte("1"), time.Minute)
+
+		// assert
+		require.NoError(t, err)
+		assert.True(t, ok)
+		value, err := cache.Get(ctx, "token")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("1"), value)
+	})
+
+	t.Run("existing key", func(t *testing.T) {
+		// arrange
+		cache := NewLRU(0)
+		require.NoError(t, cache.Set(ctx, "token", []byte("1"), time.Minute))
+
+		// act
+		ok, err := cache.SetNX(ctx, "token", []byte("2"), time.Minute)
+
+		// assert
+		require.NoError(t, err)
+		assert.False(t, ok)
+		value, err := cache.Get(ctx, "token")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("1"), value)
+	})
+
+	t.Run("expired key", func(t *testing.T) {
+		// arrange
+		now := &clock{now: time.Date(2022, 11, 15, 12, 0, 0, 0, time.UTC)}
+		cache := newLRU(0, now.Now)
+		require.NoError(t, cache.Set(ctx, "token", []byte("1"), time.Minute))
+		now.now = now.now.Add(time.Minute)
+
+		// act
+		ok, err := cache.SetNX(ctx, "token", []byte("2"), time.Minute)
+
+		// assert
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestJSON(t *testing.T) {
+	type user struct {
+		Id   uint
+		Name string
+	}
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		cache := NewLRU(0)
+		require.NoError(t, SetJSON(ctx, cache, "UserGet:1", user{Id: 1, Name: "Test Tester"}, 0))
+
+		// act
+		var result user
+		err := GetJSON(ctx, cache, "UserGet:1", &result)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, user{Id: 1, Name: "Test Tester"}, result)
+	})
+
+	t.Run("noop", func(t *testing.T) {
+		// arrange
+		cache := NewNoop()
+		require.NoError(t, SetJSON(ctx, cache, "UserGet:1", user{Id: 1}, 0))
+
+		// act
+		var result user
+		err := GetJSON(ctx, cache, "UserGet:1", &result)
+
+		// assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// act
+		cache, err := New(ModeMemory, nil, 10)
+
+		// assert
+		require.NoError(t, err)
+		assert.IsType(t, &lruCache{}, cache)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// act
+		_, err := New("memcached", nil, 0)
+
+		// assert
+		assert.ErrorIs(t, err, ErrUnknownMode)
+	})
+}
+
+func TestLoader(t *testing.T) {
+	type user struct {
+		Id   uint
+		Name string
+	}
+	ctx := context.Background()
+
+	t.Run("loads on miss", func(t *testing.T) {
+		// arrange
+		loader := NewLoader(NewLRU(0), time.Minute, time.Second, 0)
+		var calls int32
+		load := func(context.Context) (interface{}, error) {
+			atomic.AddInt32(&calls, 1)
+			return user{Id: 1, Name: "Test Tester"}, nil
+		}
+		var first user
+		require.NoError(t, loader.Load(ctx, "UserGet", "UserGet:1", &first, load))
+
+		// act
+		var result user
+		err := loader.Load(ctx, "UserGet", "UserGet:1", &result, load)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, user{Id: 1, Name: "Test Tester"}, result)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("coalesces concurrent misses", func(t *testing.T) {
+		// arrange
+		loader := NewLoader(NewLRU(0), time.Minute, time.Second, 0)
+		var calls int32
+		started := make(chan struct{})
+		release := make(chan struct{})
+		load := func(context.Context) (interface{}, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				close(started)
+			}
+			<-release
+			return user{Id: 1}, nil
+		}
+
+		// act
+		var wg sync.WaitGroup
+		results := make([]user, 10)
+		errs := make([]error, 10)
+		for n := range results {
+			wg.Add(1)
+			go func(n int) {
+				defer wg.Done()
+				errs[n] = loader.Load(ctx, "UserGet", "UserGet:1", &results[n], load)
+			}(n)
+			if n == 0 {
+				<-started
+			}
+		}
+		close(release)
+		wg.Wait()
+
+		// assert
+		// late requests find the filled cache, so the value is loaded once in any case
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		for n := range results {
+			require.NoError(t, errs[n])
+			assert.Equal(t, user{Id: 1}, results[n])
+		}
+	})
+
+	t.Run("caches absence", func(t *testing.T) {
+		// arrange
+		loader := NewLoader(NewLRU(0), time.Minute, time.Second, 0)
+		notExists := errors.New("user does not exists")
+		var calls int32
+		load := func(context.Context) (interface{}, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, Absent(notExists)
+		}
+		var first user
+		err := loader.Load(ctx, "UserGet", "UserGet:1", &first, load)
+		require.ErrorIs(t, err, notExists)
+
+		// act
+		var result user
+		err = loader.Load(ctx, "UserGet", "UserGet:1", &result, load)
+
+		// assert
+		assert.ErrorIs(t, err, ErrAbsent)
+		assert.EqualError(t, err, "user does not exists")
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		// arrange
+		loader := NewLoader(NewLRU(0), time.Minute, time.Second, 0)
+		var calls int32
+		load := func(context.Context) (interface{}, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, errors.New("db error")
+		}
+		var first user
+		require.Error(t, loader.Load(ctx, "UserGet", "UserGet:1", &first, load))
+
+		// act
+		var result user
+		err := loader.Load(ctx, "UserGet", "UserGet:1", &result, load)
+
+		// assert
+		assert.EqualError(t, err, "db error")
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		// arrange
+		loader := NewLoader(NewNoop(), time.Minute, time.Second, 0.1)
+
+		for n := 0; n < 100; n++ {
+			// act
+			ttl := loader.jittered(time.Minute)
+
+			// assert
+			assert.GreaterOrEqual(t, ttl, time.Minute)
+			assert.LessOrEqual(t, ttl, time.Minute+6*time.Second)
+		}
+		assert.Equal(t, time.Duration(0), loader.jittered(0))
+	})
+}
+
+func TestGeneration(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("bump", func(t *testing.T) {
+		// arrange
+		generation := NewGeneration(NewLRU(0), "UserListGeneration")
+		before, err := generation.Current(ctx)
+		require.NoError(t, err)
+
+		// act
+		err = generation.Bump(ctx)
+
+		// assert
+		require.NoError(t, err)
+		after, err := generation.Current(ctx)
+		require.NoError(t, err)
+		assert.NotEqual(t, before, after)
+	})
+
+	t.Run("lost counter does not return to old generations", func(t *testing.T) {
+		// arrange
+		cache := NewLRU(0)
+		generation := NewGeneration(cache, "UserListGeneration")
+		require.NoError(t, generation.Bump(ctx))
+		before, err := generation.Current(ctx)
+		require.NoError(t, err)
+		require.NoError(t, cache.Delete(ctx, "UserListGeneration"))
+
+		// act
+		err = generation.Bump(ctx)
+
+		// assert
+		require.NoError(t, err)
+		after, err := generation.Current(ctx)
+		require.NoError(t, err)
+		assert.NotEqual(t, before, after)
+		assert.NotEqual(t, "1", after)
+	})
+}
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Generation is a counter embedded in the keys of a group of values, e.g. lists of users.
+// Bumping it invalidates the whole group at once: keys of old generations are not read anymore
+// and expire by their ttl, so neither scans of keys nor races with concurrent fills are possible.
+type Generation struct {
+	cache Interface
+	key   string
+}
+
+func NewGeneration(cache Interface, key string) *Generation {
+	return &Generation{
+		cache: cache,
+		key:   key,
+	}
+}
+
+// Current returns the generation to embed in the keys of the group
+func (g *Generation) Current(ctx context.Context) (string, error) {
+	data, err := g.cache.Get(ctx, g.key)
+	if err == nil {
+		return string(data), nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return "", err
+	}
+	return g.reset(ctx)
+}
+
+// Bump must be called after the values of the group are changed in the source
+func (g *Generation) Bump(ctx context.Context) error {
+	value, err := g.cache.Incr(ctx, g.key)
+	if err != nil {
+		return err
+	}
+	if value == 1 {
+		_, err = g.reset(ctx)
+	}
+	return err
+}
+
+// reset starts the lost counter, e.g. the evicted one, from the current time,
+// so that it does not return to generations whose keys may still be cached
+func (g *Generation) reset(ctx context.Context) (string, error) {
+	value := strconv.FormatInt(time.Now().UnixNano(), 10)
+	if err := g.cache.Set(ctx, g.key, []byte(value), 0); err != nil {
+		return "", err
+	}
+	return value, nil
+}
@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/counter"
+	"golang.org/x/sync/singleflight"
+)
+
+var ErrAbsent = errors.New("value is absent")
+
+// absentMarker starts the cached absence of a value, JSON never starts with it
+const absentMarker = 0
+
+// Absent marks the error of LoadFunc as absence of the value in the source, such errors are cached
+func Absent(err error) error {
+	return &absentError{err: err}
+}
+
+type absentError struct {
+	err error
+}
+
+func (e *absentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *absentError) Unwrap() error {
+	return e.err
+}
+
+func (e *absentError) Is(target error) bool {
+	return target == ErrAbsent
+}
+
+// LoadFunc reads the value from the source on cache miss
+type LoadFunc func(ctx context.Context) (interface{}, error)
+
+// Loader fills the cache from the source of values. Concurrent misses of the same key are coalesced,
+// so that the value is loaded once and the other requests wait for it. Absence of the value is cached
+// for the shorter negative ttl, so that requests for nonexistent values do not reach the source every time.
+// TTLs are increased by a random part of them, so that values cached together do not expire together.
+type Loader struct {
+	cache       Interface
+	group       singleflight.Group
+	ttl         time.Duration
+	negativeTTL time.Duration
+	jitter      float64
+}
+
+// NewLoader returns loader caching values for ttl and absence of them for negativeTTL,
+// jitter is the max part of ttl added to it, e.g. 0.1 means up to 10%
+func NewLoader(cache Interface, ttl, negativeTTL time.Duration, jitter float64) *Loader {
+	return &Loader{
+		cache:       cache,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		jitter:      jitter,
+	}
+}
+
+// Load reads the value of the key into v, the value is loaded and cached on miss.
+// If the value is absent, the error marked by Absent is returned, it matches ErrAbsent.
+// Family groups keys in the metrics of the cache.
+func (l *Loader) Load(ctx context.Context, family, key string, v interface{}, load LoadFunc) error {
+	data, err := l.cache.Get(ctx, key)
+	if err == nil {
+		if isAbsent(data) {
+			counter.CacheNegativeHitInc(family)
+		} else {
+			counter.CacheHitInc(family)
+		}
+		return decode(key, data, v)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	counter.CacheMissInc(family)
+
+	// the value is loaded with the context of the first request, so the others get its error as well
+	result := l.group.DoChan(key, func() (interface{}, error) {
+		return l.fill(ctx, key, load)
+	})
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case r := <-result:
+		if r.Shared {
+			counter.CacheCoalescedInc(family)
+		}
+		if r.Err != nil {
+			return r.Err
+		}
+		return decode(key, r.Val.([]byte), v)
+	}
+}
+
+func (l *Loader) fill(ctx context.Context, key string, load LoadFunc) ([]byte, error) {
+	value, err := load(ctx)
+	if errors.Is(err, ErrAbsent) {
+		data := append([]byte{absentMarker}, err.Error()...)
+		if setErr := l.cache.Set(ctx, key, data, l.jittered(l.negativeTTL)); setErr != nil {
+			return nil, setErr
+		}
+		// the original error is returned, so that callers can check its cause
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cache.Loader key: [%s]", key)
+	}
+	if err := l.cache.Set(ctx, key, data, l.jittered(l.ttl)); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Store caches v for the ttl of the loader, e.g. when the value is changed
+func (l *Loader) Store(ctx context.Context, key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrapf(err, "cache.Loader key: [%s]", key)
+	}
+	return l.cache.Set(ctx, key, data, l.jittered(l.ttl))
+}
+
+// jittered keeps zero ttl as it is, because it means that the value does not expire
+func (l *Loader) jittered(ttl time.Duration) time.Duration {
+	max := int64(float64(ttl) * l.jitter)
+	if max <= 0 {
+		return ttl
+	}
+	return ttl + time.Duration(rand.Int63n(max+1))
+}
+
+func isAbsent(data []byte) bool {
+	return len(data) > 0 && data[0] == absentMarker
+}
+
+func decode(key string, data []byte, v interface{}) error {
+	if isAbsent(data) {
+		return Absent(errors.New(string(data[1:])))
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errors.Wrapf(err, "cache.Loader key: [%s]", key)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// sweepSize limits number of the least recently used entries checked for expiration on every Set
+const sweepSize = 10
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+func (e *lruEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// lruCache keeps values in the memory of the process. When the capacity is exceeded,
+// the least recently used value is evicted. Zero capacity means that values are only expired.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	// order keeps entries from the most to the least recently used
+	order *list.List
+	now   func() time.Time
+}
+
+func NewLRU(capacity int) Interface {
+	return newLRU(capacity, time.Now)
+}
+
+func newLRU(capacity int, now func() time.Time) *lruCache {
+	return &lruCache{
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+		now:      now,
+	}
+}
+
+func (c *lruCache) Get(_ context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	entry := element.Value.(*lruEntry)
+	if entry.expired(c.now()) {
+		c.remove(element)
+		return nil, ErrNotFound
+	}
+	c.order.MoveToFront(element)
+	return entry.value, nil
+}
+
+func (c *lruCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(key, value, ttl)
+	return nil
+}
+
+func (c *lruCache) SetNX(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok && !element.Value.(*lruEntry).expired(c.now()) {
+		return false, nil
+	}
+	c.set(key, value, ttl)
+	return true, nil
+}
+
+// set must be called under the lock
+func (c *lruCache) set(key string, value []byte, ttl time.Duration) {
+	now := c.now()
+	entry := &lruEntry{key: key, value: value}
+	if ttl > 0 {
+		entry.expiresAt = now.Add(ttl)
+	}
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+	} else {
+		c.entries[key] = c.order.PushFront(entry)
+	}
+
+	c.sweep(now)
+	for c.capacity > 0 && c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *lruCache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if element, ok := c.entries[key]; ok {
+			c.remove(element)
+		}
+	}
+	return nil
+}
+
+func (c *lruCache) DeleteByPrefix(_ context.Context, prefix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, element := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(element)
+		}
+	}
+	return nil
+}
+
+func (c *lruCache) Incr(_ context.Context, key string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	element, ok := c.entries[key]
+	if ok && element.Value.(*lruEntry).expired(now) {
+		c.remove(element)
+		ok = false
+	}
+	if !ok {
+		c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: []byte("1")})
+		for c.capacity > 0 && c.order.Len() > c.capacity {
+			c.remove(c.order.Back())
+		}
+		return 1, nil
+	}
+
+	entry := element.Value.(*lruEntry)
+	value, err := strconv.ParseInt(string(entry.value), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "cache.Incr key: [%s]", key)
+	}
+	value++
+	entry.value = []byte(strconv.FormatInt(value, 10))
+	c.order.MoveToFront(element)
+	return value, nil
+}
+
+// sweep removes expired entries among the least recently used ones, so that values which are
+// not read anymore do not stay in memory until they are evicted
+func (c *lruCache) sweep(now time.Time) {
+	element := c.order.Back()
+	for i := 0; i < sweepSize && element != nil; i++ {
+		prev := element.Prev()
+		if element.Value.(*lruEntry).expired(now) {
+			c.remove(element)
+		}
+		element = prev
+	}
+}
+
+func (c *lruCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*lruEntry).key)
+}
@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// noopCache keeps nothing, every value is read from the source
+type noopCache struct{}
+
+func NewNoop() Interface {
+	return noopCache{}
+}
+
+func (noopCache) Get(context.Context, string) ([]byte, error) {
+	return nil, ErrNotFound
+}
+
+func (noopCache) Set(context.Context, string, []byte, time.Duration) error {
+	return nil
+}
+
+// SetNX always sets the value, because nothing is kept
+func (noopCache) SetNX(context.Context, string, []byte, time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (noopCache) Delete(context.Context, ...string) error {
+	return nil
+}
+
+func (noopCache) DeleteByPrefix(context.Context, string) error {
+	return nil
+}
+
+// Incr always returns 1, because the value is not kept
+func (noopCache) Incr(context.Context, string) (int64, error) {
+	return 1, nil
+}
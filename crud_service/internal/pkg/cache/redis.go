@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/pkg/cache")
+
+// scanCount is a hint of number of keys returned by one SCAN call
+const scanCount = 100
+
+// globReplacer escapes special characters of Redis patterns, so that the prefix is matched literally
+var globReplacer = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `]`, `\]`)
+
+type redisCache struct {
+	client *redis.Client
+}
+
+func NewRedis(client *redis.Client) Interface {
+	return &redisCache{
+		client: client,
+	}
+}
+
+func (c *redisCache) Get(ctx context.Context, key string) ([]byte, error) {
+	_, span := c.startSpan(ctx, "GET")
+	defer span.End()
+
+	data, err := c.client.Get(key).Bytes()
+	if err == redis.Nil {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		tracing.Fail(span, err, "redis error")
+		return nil, errors.Wrapf(err, "cache.Get key: [%s]", key)
+	}
+	return data, nil
+}
+
+func (c *redisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, span := c.startSpan(ctx, "SET")
+	defer span.End()
+
+	if err := c.client.Set(key, value, ttl).Err(); err != nil {
+		tracing.Fail(span, err, "redis error")
+		return errors.Wrapf(err, "cache.Set key: [%s]", key)
+	}
+	return nil
+}
+
+func (c *redisCache) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	_, span := c.startSpan(ctx, "SETNX")
+	defer span.End()
+
+	ok, err := c.client.SetNX(key, value, ttl).Result()
+	if err != nil {
+		tracing.Fail(span, err, "redis error")
+		return false, errors.Wrapf(err, "cache.SetNX key: [%s]", key)
+	}
+	return ok, nil
+}
+
+func (c *redisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	_, span := c.startSpan(ctx, "DEL")
+	defer span.End()
+
+	if err := c.client.Del(keys...).Err(); err != nil {
+		tracing.Fail(span, err, "redis error")
+		return errors.Wrapf(err, "cache.Delete keys: %v", keys)
+	}
+	return nil
+}
+
+func (c *redisCache) Incr(ctx context.Context, key string) (int64, error) {
+	_, span := c.startSpan(ctx, "INCR")
+	defer span.End()
+
+	value, err := c.client.Incr(key).Result()
+	if err != nil {
+		tracing.Fail(span, err, "redis error")
+		return 0, errors.Wrapf(err, "cache.Incr key: [%s]", key)
+	}
+	return value, nil
+}
+
+// DeleteByPrefix scans keys instead of KEYS command, so that Redis is not blocked on large databases
+func (c *redisCache) DeleteByPrefix(ctx context.Context, prefix string) error {
+	pattern := globReplacer.Replace(prefix) + "*"
+	var cursor uint64
+	for {
+		keys, next, err := c.scan(ctx, cursor, pattern)
+		if err != nil {
+			return errors.Wrapf(err, "cache.DeleteByPrefix prefix: [%s]", prefix)
+		}
+		if err := c.Delete(ctx, keys...); err != nil {
+			return err
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+func (c *redisCache) scan(ctx context.Context, cursor uint64, pattern string) ([]string, uint64, error) {
+	_, span := c.startSpan(ctx, "SCAN")
+	defer span.End()
+
+	keys, next, err := c.client.Scan(cursor, pattern, scanCount).Result()
+	if err != nil {
+		tracing.Fail(span, err, "redis error")
+	}
+	return keys, next, err
+}
+
+// startSpan starts the span of the Redis command, keys are not recorded since they contain user data
+func (c *redisCache) startSpan(ctx context.Context, command string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, command,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemRedis,
+			semconv.DBOperationKey.String(command),
+			semconv.DBRedisDBIndexKey.Int(c.client.Options().DB),
+		),
+	)
+}
@@ -0,0 +1,73 @@
+//go:generate mockgen -source=./audit.go -destination=./mocks/audit.go -package=mock_audit
+
+// This model gives access to the audit log of changes of users
+package audit
+
+import (
+	"context"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/audit/storage"
+	postgresStoragePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/audit/storage/postgres"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+type Interface interface {
+	List(ctx context.Context, recPerPage, pageNum uint64, filter models.AuditFilter) ([]models.AuditEvent, error)
+	Count(ctx context.Context, filter models.AuditFilter) (uint64, error)
+}
+
+type core struct {
+	storage storagePkg.Interface
+}
+
+func New(pool pgxpoolmock.PgxPool) Interface {
+	return &core{
+		storage: postgresStoragePkg.New(pool),
+	}
+}
+
+func (c *core) List(ctx context.Context, recPerPage, pageNum uint64, filter models.AuditFilter) ([]models.AuditEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var result []models.AuditEvent
+	var err error
+
+	go func(ch chan struct{}) {
+		result, err = c.storage.List(ctx, recPerPage, pageNum, filter)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return result, err
+}
+
+func (c *core) Count(ctx context.Context, filter models.AuditFilter) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var result uint64
+	var err error
+
+	go func(ch chan struct{}) {
+		result, err = c.storage.Count(ctx, filter)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return result, err
+}
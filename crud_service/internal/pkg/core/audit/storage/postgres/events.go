@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/audit/storage"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/pkg/core/audit/storage/postgres")
+
+type Storage struct {
+	pool pgxpoolmock.PgxPool //*pgxpool.Pool
+}
+
+func New(pool pgxpoolmock.PgxPool) storagePkg.Interface {
+	return &Storage{
+		pool: pool,
+	}
+}
+
+// List returns events from the newest to the oldest
+func (s *Storage) List(ctx context.Context, recPerPage, pageNum uint64, filter models.AuditFilter) ([]models.AuditEvent, error) {
+	ctx, span := tracer.Start(ctx, "storage/AuditList")
+	defer span.End()
+
+	conditions, args := filterConditions(filter)
+	args = append(args, recPerPage, (pageNum-1)*recPerPage)
+
+	query := fmt.Sprintf("SELECT id, actor_id, actor_email, action, target_id, changes, request_id, created_at FROM audit_events%s ORDER BY id DESC LIMIT $%d OFFSET $%d",
+		whereClause(conditions), len(args)-1, len(args))
+
+	var result []models.AuditEvent
+	if err := pgxscan.Select(ctx, s.pool, &result, query, args...); err != nil {
+		tracing.Fail(span, err, "sql error")
+		return nil, errors.Wrap(err, "storage.AuditList: select")
+	}
+	return result, nil
+}
+
+// Count returns number of events matching the filter
+func (s *Storage) Count(ctx context.Context, filter models.AuditFilter) (uint64, error) {
+	ctx, span := tracer.Start(ctx, "storage/AuditCount")
+	defer span.End()
+
+	conditions, args := filterConditions(filter)
+	query := "SELECT COUNT(*) FROM audit_events" + whereClause(conditions)
+
+	var count uint64
+	if err := pgxscan.Get(ctx, s.pool, &count, query, args...); err != nil {
+		tracing.Fail(span, err, "sql error")
+		return 0, errors.Wrap(err, "storage.AuditCount: select")
+	}
+	return count, nil
+}
+
+func filterConditions(filter models.AuditFilter) ([]string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.ActorId != 0 {
+		addCondition("actor_id = $%d", filter.ActorId)
+	}
+	if filter.TargetId != 0 {
+		addCondition("target_id = $%d", filter.TargetId)
+	}
+	if len(filter.Actions) > 0 {
+		addCondition("action = ANY($%d)", filter.Actions)
+	}
+	if !filter.From.IsZero() {
+		addCondition("created_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		addCondition("created_at < $%d", filter.To)
+	}
+
+	return conditions, args
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+func TestAuditList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		auditStorage := New(mockPool)
+
+		createdAt := time.Date(2022, 10, 25, 12, 0, 0, 0, time.UTC)
+		expected := models.AuditEvent{
+			Id:         2,
+			ActorId:    1,
+			ActorEmail: "admin@dummy.com",
+			Action:     models.AuditActionUpdate,
+			TargetId:   5,
+			Changes: map[string]models.FieldChange{
+				"name": {Old: "Test Tester", New: "Test Tester Jr"},
+			},
+			RequestId: "abc",
+			CreatedAt: createdAt,
+		}
+
+		query := `SELECT id, actor_id, actor_email, action, target_id, changes, request_id, created_at FROM audit_events WHERE target_id = $1 AND action = ANY($2) ORDER BY id DESC LIMIT $3 OFFSET $4`
+		columns := []string{"id", "actor_id", "actor_email", "action", "target_id", "changes", "request_id", "created_at"}
+		pgxRows := pgxpoolmock.NewRows(columns).AddRow(
+			expected.Id, expected.ActorId, expected.ActorEmail, expected.Action, expected.TargetId, expected.Changes, expected.RequestId, expected.CreatedAt,
+		).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), query, uint(5), []string{models.AuditActionUpdate}, uint64(10), uint64(10)).Return(pgxRows, nil).Times(1)
+
+		// act
+		result, err := auditStorage.List(context.Background(), 10, 2, models.AuditFilter{
+			TargetId: 5,
+			Actions:  []string{models.AuditActionUpdate},
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, []models.AuditEvent{expected}, result)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		auditStorage := New(mockPool)
+
+		query := `SELECT id, actor_id, actor_email, action, target_id, changes, request_id, created_at FROM audit_events ORDER BY id DESC LIMIT $1 OFFSET $2`
+		mockPool.EXPECT().Query(gomock.Any(), query, uint64(10), uint64(0)).Return(nil, errors.New("connection refused")).Times(1)
+
+		// act
+		result, err := auditStorage.List(context.Background(), 10, 1, models.AuditFilter{})
+
+		// assert
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAuditCount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		auditStorage := New(mockPool)
+
+		from := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+		query := `SELECT COUNT(*) FROM audit_events WHERE actor_id = $1 AND created_at >= $2 AND created_at < $3`
+		pgxRows := pgxpoolmock.NewRows([]string{"count"}).AddRow(uint64(3)).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), query, uint(1), from, to).Return(pgxRows, nil).Times(1)
+
+		// act
+		result, err := auditStorage.Count(context.Background(), models.AuditFilter{
+			ActorId: 1,
+			From:    from,
+			To:      to,
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), result)
+	})
+}
@@ -0,0 +1,15 @@
+//go:generate mockgen -source=./storage.go -destination=./mocks/storage.go -package=mock_storage
+
+// This is a storage of audit events. Events are written by the user storage together with the changes
+package storage
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+type Interface interface {
+	List(ctx context.Context, recPerPage, pageNum uint64, filter models.AuditFilter) ([]models.AuditEvent, error)
+	Count(ctx context.Context, filter models.AuditFilter) (uint64, error)
+}
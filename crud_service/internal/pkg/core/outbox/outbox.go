@@ -0,0 +1,49 @@
+//go:generate mockgen -source=./outbox.go -destination=./mocks/outbox.go -package=mock_outbox
+
+// This model gives access to the transactional outbox of events of users' changes
+package outbox
+
+import (
+	"context"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/outbox/storage"
+	postgresStoragePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/outbox/storage/postgres"
+)
+
+type Interface interface {
+	Relay(ctx context.Context, limit uint64, publish storagePkg.PublishFunc) (int, error)
+}
+
+type core struct {
+	storage storagePkg.Interface
+}
+
+func New(pool pgxpoolmock.PgxPool) Interface {
+	return &core{
+		storage: postgresStoragePkg.New(pool),
+	}
+}
+
+func (c *core) Relay(ctx context.Context, limit uint64, publish storagePkg.PublishFunc) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var result int
+	var err error
+
+	go func(ch chan struct{}) {
+		result, err = c.storage.Relay(ctx, limit, publish)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return result, err
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/outbox/storage"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/pkg/core/outbox/storage/postgres")
+
+// relayLockKey is the key of the advisory lock held by the relay publishing events. Only one of the instances
+// of the service publishes at a time, otherwise events of a user could be published out of order.
+const relayLockKey = 20221110
+
+type Storage struct {
+	pool pgxpoolmock.PgxPool //*pgxpool.Pool
+}
+
+func New(pool pgxpoolmock.PgxPool) storagePkg.Interface {
+	return &Storage{
+		pool: pool,
+	}
+}
+
+// Relay keeps the events locked while they are published. Events are removed only after publishing,
+// so if the service stops in between, they are published again.
+func (s *Storage) Relay(ctx context.Context, limit uint64, publish storagePkg.PublishFunc) (int, error) {
+	ctx, span := tracer.Start(ctx, "storage/OutboxRelay")
+	defer span.End()
+
+	queryLock := `SELECT pg_try_advisory_xact_lock($1)`
+	querySelect := `SELECT id, user_id, event_type, payload, created_at FROM user_events_outbox ORDER BY id LIMIT $1`
+	queryDelete := `DELETE FROM user_events_outbox WHERE id = ANY($1)`
+
+	var published int
+	var publishErr error
+	err := s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var locked bool
+		if err := pgxscan.Get(ctx, tx, &locked, queryLock, relayLockKey); err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+
+		var events []models.OutboxEvent
+		if err := pgxscan.Select(ctx, tx, &events, querySelect, limit); err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		// the published events are removed even if publishing of the next ones has failed
+		published, publishErr = publish(events)
+		if published == 0 {
+			return nil
+		}
+		ids := make([]uint64, 0, published)
+		for _, event := range events[:published] {
+			ids = append(ids, event.Id)
+		}
+		_, err := tx.Exec(ctx, queryDelete, ids)
+		return err
+	})
+	if err != nil {
+		tracing.Fail(span, err, "sql error")
+		return 0, errors.Wrap(err, "storage.OutboxRelay")
+	}
+	if publishErr != nil {
+		tracing.Fail(span, publishErr, "publish error")
+		return published, errors.Wrapf(publishErr, "storage.OutboxRelay published: [%d]", published)
+	}
+
+	return published, nil
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+const (
+	queryLock   = `SELECT pg_try_advisory_xact_lock($1)`
+	querySelect = `SELECT id, user_id, event_type, payload, created_at FROM user_events_outbox ORDER BY id LIMIT $1`
+	queryDelete = `DELETE FROM user_events_outbox WHERE id = ANY($1)`
+)
+
+// txMock passes queries of the transaction to the mocked pool, so they are expected in the same way
+type txMock struct {
+	pgx.Tx
+	pool *pgxpoolmock.MockPgxPool
+}
+
+func (tx *txMock) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	return tx.pool.Exec(ctx, sql, arguments...)
+}
+
+func (tx *txMock) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return tx.pool.Query(ctx, sql, args...)
+}
+
+func expectTx(pool *pgxpoolmock.MockPgxPool) {
+	pool.EXPECT().BeginFunc(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f func(pgx.Tx) error) error {
+			return f(&txMock{pool: pool})
+		})
+}
+
+func expectEvents(pool *pgxpoolmock.MockPgxPool, events []models.OutboxEvent) {
+	pgxRows := pgxpoolmock.NewRows([]string{"locked"}).AddRow(true).ToPgxRows()
+	pool.EXPECT().Query(gomock.Any(), queryLock, relayLockKey).Return(pgxRows, nil)
+
+	rows := pgxpoolmock.NewRows([]string{"id", "user_id", "event_type", "payload", "created_at"})
+	for _, event := range events {
+		rows.AddRow(event.Id, event.UserId, event.Type, event.Payload, event.CreatedAt)
+	}
+	pool.EXPECT().Query(gomock.Any(), querySelect, uint64(10)).Return(rows.ToPgxRows(), nil)
+}
+
+func testEvents() []models.OutboxEvent {
+	createdAt := time.Date(2022, 11, 10, 12, 0, 0, 0, time.UTC)
+	return []models.OutboxEvent{
+		{Id: 7, UserId: 1, Type: "USER_CREATED", Payload: []byte{1}, CreatedAt: createdAt},
+		{Id: 8, UserId: 1, Type: "USER_UPDATED", Payload: []byte{2}, CreatedAt: createdAt},
+	}
+}
+
+func TestOutboxRelay(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		outboxStorage := New(mockPool)
+		events := testEvents()
+
+		expectTx(mockPool)
+		expectEvents(mockPool, events)
+		mockPool.EXPECT().Exec(gomock.Any(), queryDelete, []uint64{7, 8}).Return(pgconn.CommandTag("DELETE 2"), nil)
+
+		// act
+		var published []models.OutboxEvent
+		count, err := outboxStorage.Relay(context.Background(), 10, func(events []models.OutboxEvent) (int, error) {
+			published = events
+			return len(events), nil
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, events, published)
+	})
+
+	t.Run("locked by another relay", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		outboxStorage := New(mockPool)
+
+		expectTx(mockPool)
+		pgxRows := pgxpoolmock.NewRows([]string{"locked"}).AddRow(false).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), queryLock, relayLockKey).Return(pgxRows, nil)
+
+		// act
+		count, err := outboxStorage.Relay(context.Background(), 10, func(events []models.OutboxEvent) (int, error) {
+			t.Fatal("events must not be published")
+			return 0, nil
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		outboxStorage := New(mockPool)
+		publishErr := errors.New("broker is not available")
+
+		expectTx(mockPool)
+		expectEvents(mockPool, testEvents())
+		// only the first event is removed, the second one is published again by the next relay
+		mockPool.EXPECT().Exec(gomock.Any(), queryDelete, []uint64{7}).Return(pgconn.CommandTag("DELETE 1"), nil)
+
+		// act
+		count, err := outboxStorage.Relay(context.Background(), 10, func(events []models.OutboxEvent) (int, error) {
+			return 1, publishErr
+		})
+
+		// assert
+		assert.ErrorIs(t, err, publishErr)
+		assert.Equal(t, 1, count)
+	})
+}
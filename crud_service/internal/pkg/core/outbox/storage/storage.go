@@ -0,0 +1,19 @@
+//go:generate mockgen -source=./storage.go -destination=./mocks/storage.go -package=mock_storage
+
+// This is a storage of the transactional outbox. Events are written by the user storage together with the changes
+package storage
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+// PublishFunc publishes events in the given order and returns number of published ones,
+// it stops at the first failed event so that later events of the same user are not published before it
+type PublishFunc func(events []models.OutboxEvent) (int, error)
+
+type Interface interface {
+	// Relay passes the oldest events to publish and removes the published ones. It returns number of removed events.
+	Relay(ctx context.Context, limit uint64, publish PublishFunc) (int, error)
+}
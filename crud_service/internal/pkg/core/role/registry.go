@@ -0,0 +1,97 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+)
+
+// Source provides the actual list of roles to the registry
+type Source interface {
+	List(ctx context.Context) ([]models.Role, error)
+}
+
+// SourceFunc is an adapter to use ordinary function as a Source
+type SourceFunc func(ctx context.Context) ([]models.Role, error)
+
+func (f SourceFunc) List(ctx context.Context) ([]models.Role, error) {
+	return f(ctx)
+}
+
+// Registry is an in-process cache of roles loaded from the source.
+// It is empty until the first successful refresh.
+type Registry struct {
+	source Source
+
+	mu    sync.RWMutex
+	ids   map[string]uint8
+	names map[uint8]string
+}
+
+func NewRegistry(source Source) *Registry {
+	return &Registry{
+		source: source,
+		ids:    map[string]uint8{},
+		names:  map[uint8]string{},
+	}
+}
+
+// Refresh replaces cached roles with the ones from the source
+func (r *Registry) Refresh(ctx context.Context) error {
+	roles, err := r.source.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]uint8, len(roles))
+	names := make(map[uint8]string, len(roles))
+	for _, role := range roles {
+		ids[role.Name] = role.Id
+		names[role.Id] = role.Name
+	}
+
+	r.mu.Lock()
+	r.ids = ids
+	r.names = names
+	r.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the registry with the interval until the context is done.
+// Roles changed by other instances of the service are picked up this way.
+func (r *Registry) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := r.Refresh(ctx); err != nil {
+			loggerPkg.Logger.Log.Error(fmt.Sprintf("error during roles refresh [%v]", err))
+		}
+	}
+}
+
+func (r *Registry) GetRoleId(name string) uint8 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.ids[name]
+}
+
+func (r *Registry) GetRoleName(id uint8) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.names[id]
+}
+
+func (r *Registry) Exists(name string) bool {
+	return r.GetRoleId(name) != 0
+}
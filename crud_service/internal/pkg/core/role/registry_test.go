@@ -0,0 +1,59 @@
+package role
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+func TestRegistryRefresh(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		roles := []models.Role{
+			{Id: models.RoleAdminId, Name: models.RoleAdminName},
+			{Id: models.RoleUserId, Name: models.RoleUserName},
+		}
+		registry := NewRegistry(SourceFunc(func(ctx context.Context) ([]models.Role, error) {
+			return roles, nil
+		}))
+		require.NoError(t, registry.Refresh(context.Background()))
+		roles = []models.Role{
+			{Id: models.RoleAdminId, Name: models.RoleAdminName},
+			{Id: 3, Name: "Manager"},
+		}
+
+		// act
+		err := registry.Refresh(context.Background())
+
+		// assert
+		require.NoError(t, err)
+		assert.True(t, registry.Exists("Manager"))
+		assert.False(t, registry.Exists(models.RoleUserName))
+		assert.Equal(t, uint8(3), registry.GetRoleId("Manager"))
+		assert.Equal(t, models.RoleAdminName, registry.GetRoleName(models.RoleAdminId))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		fail := false
+		registry := NewRegistry(SourceFunc(func(ctx context.Context) ([]models.Role, error) {
+			if fail {
+				return nil, errors.New("source error")
+			}
+			return []models.Role{{Id: models.RoleAdminId, Name: models.RoleAdminName}}, nil
+		}))
+		require.NoError(t, registry.Refresh(context.Background()))
+		fail = true
+
+		// act
+		err := registry.Refresh(context.Background())
+
+		// assert
+		require.EqualError(t, err, "source error")
+		assert.True(t, registry.Exists(models.RoleAdminName), "roles loaded earlier are kept")
+	})
+}
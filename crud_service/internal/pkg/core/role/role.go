@@ -0,0 +1,118 @@
+//go:generate mockgen -source=./role.go -destination=./mocks/role.go -package=mock_role
+
+// This model contains user role object and methods
+package role
+
+import (
+	"context"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role/storage"
+	postgresStoragePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role/storage/postgres"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+type Interface interface {
+	Create(ctx context.Context, role models.Role) (uint8, error)
+	List(ctx context.Context) ([]models.Role, error)
+	Update(ctx context.Context, role models.Role) error
+	Delete(ctx context.Context, id uint8) error
+}
+
+type core struct {
+	storage storagePkg.Interface
+}
+
+func New(pool pgxpoolmock.PgxPool) Interface {
+	return &core{
+		//storage: localStoragePkg.New(),
+		storage: postgresStoragePkg.New(pool),
+	}
+}
+
+func (c *core) Create(ctx context.Context, role models.Role) (uint8, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var id uint8
+	var err error
+
+	go func(ch chan struct{}) {
+		id, err = c.storage.Add(ctx, role)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return id, err
+}
+
+func (c *core) List(ctx context.Context) ([]models.Role, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var result []models.Role
+	var err error
+
+	go func(ch chan struct{}) {
+		result, err = c.storage.List(ctx)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return result, err
+}
+
+func (c *core) Update(ctx context.Context, role models.Role) error {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var err error
+
+	go func(ch chan struct{}) {
+		err = c.storage.Update(ctx, role)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return err
+}
+
+func (c *core) Delete(ctx context.Context, id uint8) error {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var err error
+
+	go func(ch chan struct{}) {
+		err = c.storage.Delete(ctx, id)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return err
+}
@@ -0,0 +1,90 @@
+package local
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role/storage"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+type storage struct {
+	mu     sync.RWMutex
+	data   map[uint8]models.Role
+	lastId uint8
+}
+
+// New creates in-memory storage seeded with the built-in roles
+func New() storagePkg.Interface {
+	return &storage{
+		mu: sync.RWMutex{},
+		data: map[uint8]models.Role{
+			models.RoleAdminId: {Id: models.RoleAdminId, Name: models.RoleAdminName},
+			models.RoleUserId:  {Id: models.RoleUserId, Name: models.RoleUserName},
+		},
+		lastId: models.RoleUserId,
+	}
+}
+
+func (s *storage) Add(ctx context.Context, role models.Role) (uint8, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.exists(role) {
+		return 0, errors.Wrapf(storagePkg.ErrRoleExists, "role: [%s]", role.Name)
+	}
+	s.lastId++
+	role.Id = s.lastId
+	s.data[role.Id] = role
+	return role.Id, nil
+}
+
+func (s *storage) List(ctx context.Context) ([]models.Role, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]models.Role, 0, len(s.data))
+	for _, value := range s.data {
+		result = append(result, value)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Id < result[j].Id })
+	return result, nil
+}
+
+func (s *storage) Update(ctx context.Context, role models.Role) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.data[role.Id]; !ok {
+		return errors.Wrapf(storagePkg.ErrRoleNotExists, "role-id: [%s]", strconv.FormatUint(uint64(role.Id), 10))
+	}
+	if s.exists(role) {
+		return errors.Wrapf(storagePkg.ErrRoleExists, "role: [%s]", role.Name)
+	}
+	s.data[role.Id] = role
+	return nil
+}
+
+func (s *storage) Delete(ctx context.Context, id uint8) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.data[id]; !ok {
+		return errors.Wrapf(storagePkg.ErrRoleNotExists, "role-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	}
+	delete(s.data, id)
+	return nil
+}
+
+// exists checks whether other role with the same name is stored
+func (s *storage) exists(role models.Role) bool {
+	for _, value := range s.data {
+		if value.Name == role.Name && value.Id != role.Id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role/storage"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role/storage/postgres")
+
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+type Storage struct {
+	pool pgxpoolmock.PgxPool //*pgxpool.Pool
+}
+
+func New(pool pgxpoolmock.PgxPool) storagePkg.Interface {
+	return &Storage{
+		pool: pool,
+	}
+}
+
+func (s *Storage) Add(ctx context.Context, role models.Role) (uint8, error) {
+	ctx, span := tracer.Start(ctx, "storage/RoleAdd")
+	defer span.End()
+
+	query := `INSERT INTO roles (name) VALUES ($1) RETURNING id`
+	rows, err := s.pool.Query(ctx, query, role.Name)
+	if err != nil {
+		tracing.Fail(span, err, "sql error")
+		return 0, errors.Wrapf(err, "storage.RoleAdd role: [%s]", role.Name)
+	}
+	var id uint8
+	if err := pgxscan.ScanOne(&id, rows); err != nil {
+		if pgErrorCode(err) == pgUniqueViolation {
+			return 0, errors.Wrapf(storagePkg.ErrRoleExists, "role: [%s]", role.Name)
+		}
+		tracing.Fail(span, err, "scanone error")
+		return 0, errors.Wrapf(err, "storage.RoleAdd role: [%s]", role.Name)
+	}
+	return id, nil
+}
+
+func (s *Storage) List(ctx context.Context) ([]models.Role, error) {
+	ctx, span := tracer.Start(ctx, "storage/RoleList")
+	defer span.End()
+
+	query := `SELECT id, name FROM roles ORDER BY id`
+
+	var result []models.Role
+	if err := pgxscan.Select(ctx, s.pool, &result, query); err != nil {
+		tracing.Fail(span, err, "sql error")
+		return nil, errors.Wrap(err, "storage.RoleList: select")
+	}
+	return result, nil
+}
+
+func (s *Storage) Update(ctx context.Context, role models.Role) error {
+	ctx, span := tracer.Start(ctx, "storage/RoleUpdate")
+	defer span.End()
+
+	query := `UPDATE roles SET name = $2 WHERE id = $1`
+	result, err := s.pool.Exec(ctx, query, role.Id, role.Name)
+	if err != nil {
+		if pgErrorCode(err) == pgUniqueViolation {
+			return errors.Wrapf(storagePkg.ErrRoleExists, "role: [%s]", role.Name)
+		}
+		tracing.Fail(span, err, "sql error")
+		return errors.Wrapf(err, "storage.RoleUpdate role-id: [%s]", strconv.FormatUint(uint64(role.Id), 10))
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.Wrapf(storagePkg.ErrRoleNotExists, "role-id: [%s]", strconv.FormatUint(uint64(role.Id), 10))
+	}
+	return nil
+}
+
+func (s *Storage) Delete(ctx context.Context, id uint8) error {
+	ctx, span := tracer.Start(ctx, "storage/RoleDelete")
+	defer span.End()
+
+	query := `DELETE FROM roles WHERE id = $1`
+	result, err := s.pool.Exec(ctx, query, id)
+	if err != nil {
+		if pgErrorCode(err) == pgForeignKeyViolation {
+			return errors.Wrapf(storagePkg.ErrRoleInUse, "role-id: [%s]", strconv.FormatUint(uint64(id), 10))
+		}
+		tracing.Fail(span, err, "sql error")
+		return errors.Wrapf(err, "storage.RoleDelete role-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.Wrapf(storagePkg.ErrRoleNotExists, "role-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	}
+	return nil
+}
+
+func pgErrorCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+}
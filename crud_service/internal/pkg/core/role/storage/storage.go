@@ -0,0 +1,22 @@
+//go:generate mockgen -source=./storage.go -destination=./mocks/storage.go -package=mock_storage
+
+// This is a storage that contains list of user roles
+package storage
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+var ErrRoleNotExists = errors.New("role does not exists")
+var ErrRoleExists = errors.New("role already exists")
+var ErrRoleInUse = errors.New("role is assigned to users")
+
+type Interface interface {
+	Add(ctx context.Context, role models.Role) (uint8, error)
+	List(ctx context.Context) ([]models.Role, error)
+	Update(ctx context.Context, role models.Role) error
+	Delete(ctx context.Context, id uint8) error
+}
@@ -6,6 +6,7 @@ package mock_user
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	models "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
@@ -35,6 +36,21 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockInterface) Count(ctx context.Context, filter models.UserFilter) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockInterfaceMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockInterface)(nil).Count), ctx, filter)
+}
+
 // Create mocks base method.
 func (m *MockInterface) Create(ctx context.Context, user models.User) (uint, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +66,21 @@ func (mr *MockInterfaceMockRecorder) Create(ctx, user interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInterface)(nil).Create), ctx, user)
 }
 
+// CreateBatch mocks base method.
+func (m *MockInterface) CreateBatch(ctx context.Context, users []models.User) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, users)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockInterfaceMockRecorder) CreateBatch(ctx, users interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockInterface)(nil).CreateBatch), ctx, users)
+}
+
 // Delete mocks base method.
 func (m *MockInterface) Delete(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
@@ -65,18 +96,33 @@ func (mr *MockInterfaceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 }
 
 // Get mocks base method.
-func (m *MockInterface) Get(ctx context.Context, id uint) (*models.User, error) {
+func (m *MockInterface) Get(ctx context.Context, id uint, includeDeleted bool) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret := m.ctrl.Call(m, "Get", ctx, id, includeDeleted)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockInterfaceMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+func (mr *MockInterfaceMockRecorder) Get(ctx, id, includeDeleted interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInterface)(nil).Get), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInterface)(nil).Get), ctx, id, includeDeleted)
+}
+
+// GetByEmail mocks base method.
+func (m *MockInterface) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmail", ctx, email)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmail indicates an expected call of GetByEmail.
+func (mr *MockInterfaceMockRecorder) GetByEmail(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockInterface)(nil).GetByEmail), ctx, email)
 }
 
 // GetRoleIdByName mocks base method.
@@ -95,30 +141,103 @@ func (mr *MockInterfaceMockRecorder) GetRoleIdByName(ctx, roleName interface{})
 }
 
 // List mocks base method.
-func (m *MockInterface) List(ctx context.Context, recPerPage, pageNum uint64, sortingOrder models.SortingOrder) ([]models.User, error) {
+func (m *MockInterface) List(ctx context.Context, recPerPage, pageNum uint64, sortingOrder models.SortingOrder, filter models.UserFilter) ([]models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, recPerPage, pageNum, sortingOrder)
+	ret := m.ctrl.Call(m, "List", ctx, recPerPage, pageNum, sortingOrder, filter)
 	ret0, _ := ret[0].([]models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockInterfaceMockRecorder) List(ctx, recPerPage, pageNum, sortingOrder interface{}) *gomock.Call {
+func (mr *MockInterfaceMockRecorder) List(ctx, recPerPage, pageNum, sortingOrder, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInterface)(nil).List), ctx, recPerPage, pageNum, sortingOrder)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInterface)(nil).List), ctx, recPerPage, pageNum, sortingOrder, filter)
 }
 
-// Update mocks base method.
-func (m *MockInterface) Update(ctx context.Context, user models.User) error {
+// ListByCursor mocks base method.
+func (m *MockInterface) ListByCursor(ctx context.Context, limit uint64, sortingOrder models.SortingOrder, filter models.UserFilter, after *models.Cursor) ([]models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, user)
+	ret := m.ctrl.Call(m, "ListByCursor", ctx, limit, sortingOrder, filter, after)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCursor indicates an expected call of ListByCursor.
+func (mr *MockInterfaceMockRecorder) ListByCursor(ctx, limit, sortingOrder, filter, after interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCursor", reflect.TypeOf((*MockInterface)(nil).ListByCursor), ctx, limit, sortingOrder, filter, after)
+}
+
+// Purge mocks base method.
+func (m *MockInterface) Purge(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockInterfaceMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockInterface)(nil).Purge), ctx, id)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockInterface) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockInterfaceMockRecorder) PurgeDeleted(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockInterface)(nil).PurgeDeleted), ctx, before)
+}
+
+// Restore mocks base method.
+func (m *MockInterface) Restore(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Restore indicates an expected call of Restore.
+func (mr *MockInterfaceMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockInterface)(nil).Restore), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockInterface) Update(ctx context.Context, user models.User) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, user)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Update indicates an expected call of Update.
 func (mr *MockInterfaceMockRecorder) Update(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInterface)(nil).Update), ctx, user)
 }
+
+// UpgradePasswordHash mocks base method.
+func (m *MockInterface) UpgradePasswordHash(ctx context.Context, id uint, oldHash, newHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradePasswordHash", ctx, id, oldHash, newHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpgradePasswordHash indicates an expected call of UpgradePasswordHash.
+func (mr *MockInterfaceMockRecorder) UpgradePasswordHash(ctx, id, oldHash, newHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradePasswordHash", reflect.TypeOf((*MockInterface)(nil).UpgradePasswordHash), ctx, id, oldHash, newHash)
+}
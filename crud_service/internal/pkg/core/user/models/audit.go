@@ -0,0 +1,76 @@
+// This model contains audit events. An event is recorded for every change of a user
+
+package models
+
+import "time"
+
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+	AuditActionPurge   = "purge"
+)
+
+// Redacted replaces values which must not get into the audit log
+const Redacted = "[redacted]"
+
+// FieldChange keeps values of a user's field before and after the change
+type FieldChange struct {
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+// AuditEvent is a record of a change of the user. ActorId is 0 if the change is made by the service itself.
+type AuditEvent struct {
+	Id         uint64                 `db:"id"`
+	ActorId    uint                   `db:"actor_id"`
+	ActorEmail string                 `db:"actor_email"`
+	Action     string                 `db:"action"`
+	TargetId   uint                   `db:"target_id"`
+	Changes    map[string]FieldChange `db:"changes"`
+	RequestId  string                 `db:"request_id"`
+	CreatedAt  time.Time              `db:"created_at"`
+}
+
+// AuditFilter restricts list of audit events. All conditions are combined with AND,
+// zero values are not applied. Time range includes From and excludes To.
+type AuditFilter struct {
+	ActorId  uint
+	TargetId uint
+	Actions  []string
+	From     time.Time
+	To       time.Time
+}
+
+// IsAuditAction reports whether the action is recorded in the audit log
+func IsAuditAction(action string) bool {
+	switch action {
+	case AuditActionCreate, AuditActionUpdate, AuditActionDelete, AuditActionRestore, AuditActionPurge:
+		return true
+	}
+	return false
+}
+
+// UserChanges returns fields which differ between old and new state of the user.
+// Password hashes are never put into changes, only the fact of password change is recorded.
+func UserChanges(old, new User) map[string]FieldChange {
+	changes := map[string]FieldChange{}
+	addChange := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes[field] = FieldChange{Old: oldValue, New: newValue}
+		}
+	}
+
+	addChange("email", old.Email, new.Email)
+	addChange("name", old.Name, new.Name)
+	addChange("role", old.Role, new.Role)
+	if old.Password != new.Password {
+		change := FieldChange{New: Redacted}
+		if old.Password != "" {
+			change.Old = Redacted
+		}
+		changes["password"] = change
+	}
+	return changes
+}
@@ -1,11 +1,26 @@
 package models
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 type User struct {
 	Id       uint   `db:"id"`
 	Email    string `db:"email"`
 	Name     string `db:"full_name"`
 	Role     string `db:"role"`
 	Password string `db:"password"`
+	// DeletedAt is set when the user is soft deleted
+	DeletedAt *time.Time `db:"deleted_at"`
+	// Version is incremented by every update. Zero version passed to update means that it is not checked.
+	Version uint64 `db:"version"`
+}
+
+// IsDeleted reports whether the user is soft deleted
+func (u User) IsDeleted() bool {
+	return u.DeletedAt != nil
 }
 
 type SortingOrder struct {
@@ -13,6 +28,77 @@ type SortingOrder struct {
 	Descending bool
 }
 
+// Cursor points to the last user of the previous page in keyset pagination.
+// Users are ordered by the sorting field and then by id.
+type Cursor struct {
+	SortingOrder
+	Value string
+	Id    uint
+}
+
+// SortingValue returns value of the user's field used for sorting
+func (u User) SortingValue(field string) string {
+	switch field {
+	case "email":
+		return u.Email
+	case "name":
+		return u.Name
+	}
+	return ""
+}
+
+// UserFilter restricts list of users. All conditions are combined with AND,
+// zero values are not applied. Substrings and prefixes are matched case-insensitively.
+type UserFilter struct {
+	Roles         []string
+	EmailContains string
+	EmailPrefix   string
+	NameContains  string
+	NamePrefix    string
+	IdFrom        uint
+	IdTo          uint
+	// IncludeDeleted makes soft deleted users match the filter, they are skipped otherwise
+	IncludeDeleted bool
+}
+
+// Match checks the user against the filter in the same way as it is done by SQL storage
+func (f UserFilter) Match(user User) bool {
+	if user.IsDeleted() && !f.IncludeDeleted {
+		return false
+	}
+	if len(f.Roles) > 0 {
+		found := false
+		for _, role := range f.Roles {
+			if role == user.Role {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	email := strings.ToLower(user.Email)
+	name := strings.ToLower(user.Name)
+	switch {
+	case !strings.Contains(email, strings.ToLower(f.EmailContains)),
+		!strings.HasPrefix(email, strings.ToLower(f.EmailPrefix)),
+		!strings.Contains(name, strings.ToLower(f.NameContains)),
+		!strings.HasPrefix(name, strings.ToLower(f.NamePrefix)),
+		f.IdFrom != 0 && user.Id < f.IdFrom,
+		f.IdTo != 0 && user.Id > f.IdTo:
+		return false
+	}
+	return true
+}
+
+// String returns unambiguous text representation of the filter which is used in cache keys
+func (f UserFilter) String() string {
+	return fmt.Sprintf("roles=%q;email~%q;email^%q;name~%q;name^%q;id=%d-%d;deleted=%t",
+		f.Roles, f.EmailContains, f.EmailPrefix, f.NameContains, f.NamePrefix, f.IdFrom, f.IdTo, f.IncludeDeleted)
+}
+
 type SortingField map[string]string
 
 var sortingFields SortingField
@@ -0,0 +1,15 @@
+// This model contains events of the transactional outbox. An event is written together with the change of a user
+// and published to the message broker afterwards, so that a change is never lost or published without being made
+
+package models
+
+import "time"
+
+// OutboxEvent is the encoded event of a user's change waiting for publishing to the message broker
+type OutboxEvent struct {
+	Id        uint64    `db:"id"`
+	UserId    uint      `db:"user_id"`
+	Type      string    `db:"event_type"`
+	Payload   []byte    `db:"payload"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -1,35 +1,24 @@
-// This model contains list of accepted user roles
+// This model contains user roles. Roles are kept in the roles table, the built-in ones are seeded by the init migration
 
 package models
 
 const (
-	roleAdminId    = 1
-	roleUserId     = 2
-	roleAadminName = "Admin"
-	roleUserName   = "User"
+	RoleAdminId = 1
+	RoleUserId  = 2
 )
 
-type RoleId map[string]uint8
-type RoleName map[uint8]string
-
-// We use 2 symmetric maps in order to provide quick access to data by roles' Id and Name
-var roleNames RoleName
-var roleIds RoleId
-
-func init() {
-	roleNames = RoleName{}
-	roleNames[roleAdminId] = roleAadminName
-	roleNames[roleUserId] = roleUserName
-
-	roleIds = RoleId{}
-	roleIds[roleAadminName] = roleAdminId
-	roleIds[roleUserName] = roleUserId
-}
+const (
+	RoleAdminName = "Admin"
+	RoleUserName  = "User"
+)
 
-func GetRoleName(id uint8) string {
-	return roleNames[id]
+type Role struct {
+	Id   uint8  `db:"id"`
+	Name string `db:"name"`
 }
 
-func GetRoleId(roleName string) uint8 {
-	return roleIds[roleName]
+// IsBuiltin reports whether the role is used by the services' permissions and therefore
+// cannot be renamed or deleted
+func (r Role) IsBuiltin() bool {
+	return r.Id == RoleAdminId || r.Id == RoleUserId
 }
@@ -2,36 +2,38 @@ package local
 
 import (
 	"context"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
+	roleStoragePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role/storage"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage"
 )
 
 const poolSize = 10
 
-var ErrUserNotExists = errors.New("user does not exists")
-var ErrUserExists = errors.New("user already exists")
-
 var lastId uint
 
 type storage struct {
 	mu     sync.RWMutex
 	data   map[uint]models.User
+	roles  roleStoragePkg.Interface
 	poolCh chan struct{}
 }
 
-func New() storagePkg.Interface {
+func New(roles roleStoragePkg.Interface) storagePkg.Interface {
 	return &storage{
 		mu:     sync.RWMutex{},
 		data:   map[uint]models.User{},
+		roles:  roles,
 		poolCh: make(chan struct{}, poolSize),
 	}
 }
 
-func (s *storage) List(ctx context.Context, _, _ uint64, _ models.SortingOrder) ([]models.User, error) {
+func (s *storage) List(ctx context.Context, recPerPage, pageNum uint64, sortingOrder models.SortingOrder, filter models.UserFilter) ([]models.User, error) {
 	s.poolCh <- struct{}{}
 	s.mu.RLock()
 	defer func() {
@@ -41,12 +43,89 @@ func (s *storage) List(ctx context.Context, _, _ uint64, _ models.SortingOrder)
 
 	result := make([]models.User, 0, len(s.data))
 	for _, value := range s.data {
+		if filter.Match(value) {
+			result = append(result, value)
+		}
+	}
+	less := lessFunc(sortingOrder)
+	sort.Slice(result, func(i, j int) bool { return less(result[i], result[j]) })
+
+	offset := (pageNum - 1) * recPerPage
+	if offset >= uint64(len(result)) {
+		return []models.User{}, nil
+	}
+	result = result[offset:]
+	if uint64(len(result)) > recPerPage {
+		result = result[:recPerPage]
+	}
+	return result, nil
+}
+
+// lessFunc returns the function reporting whether user a goes before user b in the order,
+// users with the same value of the sorting field are ordered by id
+func lessFunc(sortingOrder models.SortingOrder) func(a, b models.User) bool {
+	return func(a, b models.User) bool {
+		if sortingOrder.Descending {
+			a, b = b, a
+		}
+		valueA, valueB := a.SortingValue(sortingOrder.Field), b.SortingValue(sortingOrder.Field)
+		if valueA != valueB {
+			return valueA < valueB
+		}
+		return a.Id < b.Id
+	}
+}
+
+func (s *storage) ListByCursor(ctx context.Context, limit uint64, sortingOrder models.SortingOrder, filter models.UserFilter, after *models.Cursor) ([]models.User, error) {
+	s.poolCh <- struct{}{}
+	s.mu.RLock()
+	defer func() {
+		s.mu.RUnlock()
+		<-s.poolCh
+	}()
+
+	less := lessFunc(sortingOrder)
+
+	result := make([]models.User, 0, len(s.data))
+	for _, value := range s.data {
+		if !filter.Match(value) {
+			continue
+		}
+		if after != nil && !less(models.User{Id: after.Id, Email: after.Value, Name: after.Value}, value) {
+			continue
+		}
 		result = append(result, value)
 	}
+	sort.Slice(result, func(i, j int) bool { return less(result[i], result[j]) })
+
+	if uint64(len(result)) > limit {
+		result = result[:limit]
+	}
 	return result, nil
 }
 
+func (s *storage) Count(ctx context.Context, filter models.UserFilter) (uint64, error) {
+	s.poolCh <- struct{}{}
+	s.mu.RLock()
+	defer func() {
+		s.mu.RUnlock()
+		<-s.poolCh
+	}()
+
+	var count uint64
+	for _, value := range s.data {
+		if filter.Match(value) {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func (s *storage) Add(ctx context.Context, user models.User) (uint, error) {
+	if _, err := s.GetRoleIdByName(ctx, user.Role); err != nil {
+		return 0, errors.Wrapf(err, "storage.Add user-email: [%s] user-name: [%s]", user.Email, user.Name)
+	}
+
 	s.poolCh <- struct{}{}
 	s.mu.Lock()
 	defer func() {
@@ -54,16 +133,17 @@ func (s *storage) Add(ctx context.Context, user models.User) (uint, error) {
 		<-s.poolCh
 	}()
 
-	if _, ok := s.data[user.Id]; ok {
-		return 0, errors.Wrapf(ErrUserExists, "user-id: [%s]", strconv.FormatUint(uint64(user.Id), 10))
+	if found, ok := s.findByEmail(user.Email); ok {
+		return 0, errors.Wrapf(storagePkg.ErrUserExists, "user-id: [%s] user-email: [%s]", strconv.FormatUint(uint64(found.Id), 10), found.Email)
 	}
 	lastId++
 	user.Id = lastId
+	user.Version = 1
 	s.data[user.Id] = user
 	return user.Id, nil
 }
 
-func (s *storage) Update(ctx context.Context, user models.User) error {
+func (s *storage) AddBatch(ctx context.Context, users []models.User) ([]uint, error) {
 	s.poolCh <- struct{}{}
 	s.mu.Lock()
 	defer func() {
@@ -71,10 +151,77 @@ func (s *storage) Update(ctx context.Context, user models.User) error {
 		<-s.poolCh
 	}()
 
-	if _, ok := s.data[user.Id]; !ok {
-		return errors.Wrapf(ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(user.Id), 10))
+	// all users are checked before adding, so that none of them is added if any fails
+	emails := make(map[string]struct{}, len(s.data)+len(users))
+	for _, user := range s.data {
+		if !user.IsDeleted() {
+			emails[user.Email] = struct{}{}
+		}
+	}
+	for index, user := range users {
+		if _, err := s.GetRoleIdByName(ctx, user.Role); err != nil {
+			return nil, &storagePkg.BatchItemError{Index: index, Err: err}
+		}
+		if _, ok := emails[user.Email]; ok {
+			return nil, &storagePkg.BatchItemError{Index: index, Err: errors.Wrapf(storagePkg.ErrUserExists, "user-email: [%s]", user.Email)}
+		}
+		emails[user.Email] = struct{}{}
 	}
+
+	ids := make([]uint, 0, len(users))
+	for _, user := range users {
+		lastId++
+		user.Id = lastId
+		user.Version = 1
+		s.data[user.Id] = user
+		ids = append(ids, user.Id)
+	}
+	return ids, nil
+}
+
+func (s *storage) Update(ctx context.Context, user models.User) (uint64, error) {
+	if _, err := s.GetRoleIdByName(ctx, user.Role); err != nil {
+		return 0, errors.Wrapf(err, "storage.Update user-role: [%s]", user.Role)
+	}
+
+	s.poolCh <- struct{}{}
+	s.mu.Lock()
+	defer func() {
+		s.mu.Unlock()
+		<-s.poolCh
+	}()
+
+	// checks that new email does not belong some other user
+	if other, ok := s.findByEmail(user.Email); ok && other.Id != user.Id {
+		return 0, errors.Wrapf(storagePkg.ErrUserExists, "user-id: [%s] user-email: [%s]", strconv.FormatUint(uint64(other.Id), 10), other.Email)
+	}
+
+	found, ok := s.data[user.Id]
+	if !ok || found.IsDeleted() {
+		return 0, errors.Wrapf(storagePkg.ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(user.Id), 10))
+	}
+	if user.Version != 0 && user.Version != found.Version {
+		return 0, errors.Wrapf(storagePkg.ErrVersionMismatch, "user-id: [%s]", strconv.FormatUint(uint64(user.Id), 10))
+	}
+	user.Version = found.Version + 1
 	s.data[user.Id] = user
+	return user.Version, nil
+}
+
+func (s *storage) UpgradePasswordHash(ctx context.Context, id uint, oldHash, newHash string) error {
+	s.poolCh <- struct{}{}
+	s.mu.Lock()
+	defer func() {
+		s.mu.Unlock()
+		<-s.poolCh
+	}()
+
+	user, ok := s.data[id]
+	if !ok || user.IsDeleted() || user.Password != oldHash {
+		return errors.Wrapf(storagePkg.ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	}
+	user.Password = newHash
+	s.data[id] = user
 	return nil
 }
 
@@ -86,14 +233,73 @@ func (s *storage) Delete(ctx context.Context, id uint) error {
 		<-s.poolCh
 	}()
 
-	if _, ok := s.data[id]; !ok {
-		return errors.Wrapf(ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	user, ok := s.data[id]
+	if !ok || user.IsDeleted() {
+		return errors.Wrapf(storagePkg.ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	}
+	now := time.Now()
+	user.DeletedAt = &now
+	s.data[id] = user
+	return nil
+}
+
+func (s *storage) Restore(ctx context.Context, id uint) error {
+	s.poolCh <- struct{}{}
+	s.mu.Lock()
+	defer func() {
+		s.mu.Unlock()
+		<-s.poolCh
+	}()
+
+	user, ok := s.data[id]
+	if !ok || !user.IsDeleted() {
+		return errors.Wrapf(storagePkg.ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	}
+	for _, value := range s.data {
+		if !value.IsDeleted() && value.Email == user.Email {
+			return errors.Wrapf(storagePkg.ErrUserExists, "user-email: [%s]", user.Email)
+		}
+	}
+	user.DeletedAt = nil
+	s.data[id] = user
+	return nil
+}
+
+func (s *storage) Purge(ctx context.Context, id uint) error {
+	s.poolCh <- struct{}{}
+	s.mu.Lock()
+	defer func() {
+		s.mu.Unlock()
+		<-s.poolCh
+	}()
+
+	user, ok := s.data[id]
+	if !ok || !user.IsDeleted() {
+		return errors.Wrapf(storagePkg.ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(id), 10))
 	}
 	delete(s.data, id)
 	return nil
 }
 
-func (s *storage) Get(ctx context.Context, id uint) (*models.User, error) {
+func (s *storage) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	s.poolCh <- struct{}{}
+	s.mu.Lock()
+	defer func() {
+		s.mu.Unlock()
+		<-s.poolCh
+	}()
+
+	var count int64
+	for id, user := range s.data {
+		if user.IsDeleted() && user.DeletedAt.Before(before) {
+			delete(s.data, id)
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *storage) Get(ctx context.Context, id uint, includeDeleted bool) (*models.User, error) {
 	s.poolCh <- struct{}{}
 	s.mu.RLock()
 	defer func() {
@@ -102,17 +308,45 @@ func (s *storage) Get(ctx context.Context, id uint) (*models.User, error) {
 	}()
 
 	user, ok := s.data[id]
-	if !ok {
-		return nil, errors.Wrapf(ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	if !ok || (user.IsDeleted() && !includeDeleted) {
+		return nil, errors.Wrapf(storagePkg.ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(id), 10))
 	}
 	return &user, nil
 }
 
+func (s *storage) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	s.poolCh <- struct{}{}
+	s.mu.RLock()
+	defer func() {
+		s.mu.RUnlock()
+		<-s.poolCh
+	}()
+
+	if user, ok := s.findByEmail(email); ok {
+		return &user, nil
+	}
+	return nil, errors.Wrapf(storagePkg.ErrUserNotExists, "user-email: [%s]", email)
+}
+
+// findByEmail returns the active user with the email, the caller must hold the lock
+func (s *storage) findByEmail(email string) (models.User, bool) {
+	for _, user := range s.data {
+		if user.Email == email && !user.IsDeleted() {
+			return user, true
+		}
+	}
+	return models.User{}, false
+}
+
 func (s *storage) GetRoleIdByName(ctx context.Context, roleName string) (uint8, error) {
-	roleId := models.GetRoleId(roleName)
-	var err error
-	if roleId == 0 {
-		err = errors.Errorf("role [%s] not found", roleName)
+	roles, err := s.roles.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, role := range roles {
+		if role.Name == roleName {
+			return role.Id, nil
+		}
 	}
-	return roleId, err
+	return 0, errors.Wrapf(storagePkg.ErrRoleNotExists, "storage.getRoleByName role: [%s]", roleName)
 }
@@ -0,0 +1,104 @@
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	roleStoragePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/role/storage/local"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage"
+)
+
+func TestAdd(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("duplicate email", func(t *testing.T) {
+		// arrange
+		s := New(roleStoragePkg.New())
+		_, err := s.Add(ctx, models.User{Email: "test01@dummy.com", Role: models.RoleUserName})
+		require.NoError(t, err)
+
+		// act
+		_, err = s.Add(ctx, models.User{Email: "test01@dummy.com", Role: models.RoleUserName})
+
+		// assert
+		assert.ErrorIs(t, err, storagePkg.ErrUserExists)
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		// arrange
+		s := New(roleStoragePkg.New())
+
+		// act
+		_, err := s.Add(ctx, models.User{Email: "test01@dummy.com", Role: "Guest"})
+
+		// assert
+		assert.ErrorIs(t, err, storagePkg.ErrRoleNotExists)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not found", func(t *testing.T) {
+		// arrange
+		s := New(roleStoragePkg.New())
+
+		// act
+		_, err := s.Update(ctx, models.User{Id: 1000, Email: "test01@dummy.com", Role: models.RoleUserName})
+
+		// assert
+		assert.ErrorIs(t, err, storagePkg.ErrUserNotExists)
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		// arrange
+		s := New(roleStoragePkg.New())
+		id, err := s.Add(ctx, models.User{Email: "test01@dummy.com", Role: models.RoleUserName})
+		require.NoError(t, err)
+
+		// act
+		_, err = s.Update(ctx, models.User{Id: id, Email: "test01@dummy.com", Role: models.RoleUserName, Version: 5})
+
+		// assert
+		assert.ErrorIs(t, err, storagePkg.ErrVersionMismatch)
+	})
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sorted page", func(t *testing.T) {
+		// arrange
+		s := New(roleStoragePkg.New())
+		for _, email := range []string{"c@dummy.com", "a@dummy.com", "d@dummy.com", "b@dummy.com"} {
+			_, err := s.Add(ctx, models.User{Email: email, Role: models.RoleUserName})
+			require.NoError(t, err)
+		}
+
+		// act
+		users, err := s.List(ctx, 2, 2, models.SortingOrder{Field: "email", Descending: true}, models.UserFilter{})
+
+		// assert
+		require.NoError(t, err)
+		require.Len(t, users, 2)
+		assert.Equal(t, "b@dummy.com", users[0].Email)
+		assert.Equal(t, "a@dummy.com", users[1].Email)
+	})
+
+	t.Run("page after the last one", func(t *testing.T) {
+		// arrange
+		s := New(roleStoragePkg.New())
+		_, err := s.Add(ctx, models.User{Email: "a@dummy.com", Role: models.RoleUserName})
+		require.NoError(t, err)
+
+		// act
+		users, err := s.List(ctx, 10, 2, models.SortingOrder{Field: "email"}, models.UserFilter{})
+
+		// assert
+		require.NoError(t, err)
+		assert.Empty(t, users)
+	})
+}
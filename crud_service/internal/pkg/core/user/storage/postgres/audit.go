@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/requestid"
+)
+
+const queryAuditInsert = `INSERT INTO audit_events (actor_id, actor_email, action, target_id, changes, request_id) VALUES ($1, $2, $3, $4, $5, $6)`
+
+// writeAuditEvent records the change of the user in the transaction of the change itself,
+// so that the change is not made if the event cannot be recorded
+func writeAuditEvent(ctx context.Context, tx pgx.Tx, action string, targetId uint, changes map[string]models.FieldChange) error {
+	actorId, actorEmail := actor(ctx)
+	if changes == nil {
+		changes = map[string]models.FieldChange{}
+	}
+
+	if _, err := tx.Exec(ctx, queryAuditInsert, actorId, actorEmail, action, targetId, changes, requestid.FromContext(ctx)); err != nil {
+		return errors.Wrapf(err, "storage.writeAuditEvent action: [%s] user-id: [%s]", action, strconv.FormatUint(uint64(targetId), 10))
+	}
+	return nil
+}
+
+// actor returns the authenticated caller, changes made by the service itself have zero actor
+func actor(ctx context.Context) (uint, string) {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if !ok {
+		return 0, ""
+	}
+	return identity.Id, identity.Email
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/requestid"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const queryOutboxInsert = `INSERT INTO user_events_outbox (user_id, event_type, payload) VALUES ($1, $2, $3)`
+
+// writeUserEvent puts the event of the change into the outbox in the transaction of the change itself,
+// the relay publishes it after the commit. The user is nil for deleted users.
+func writeUserEvent(ctx context.Context, tx pgx.Tx, eventType pb.UserEvent_Type, userId uint, user *models.User, changes map[string]models.FieldChange) error {
+	event := &pb.UserEvent{
+		Type:       eventType,
+		UserId:     uint64(userId),
+		RequestId:  requestid.FromContext(ctx),
+		OccurredAt: timestamppb.New(time.Now()),
+	}
+	if user != nil {
+		event.User = &pb.UserEvent_User{
+			Email:   user.Email,
+			Name:    user.Name,
+			Role:    user.Role,
+			Version: user.Version,
+		}
+	}
+	for field := range changes {
+		event.ChangedFields = append(event.ChangedFields, field)
+	}
+	sort.Strings(event.ChangedFields)
+
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return errors.Wrapf(err, "storage.writeUserEvent marshal event: [%s] user-id: [%s]", eventType, strconv.FormatUint(uint64(userId), 10))
+	}
+	if _, err := tx.Exec(ctx, queryOutboxInsert, userId, eventType.String(), payload); err != nil {
+		return errors.Wrapf(err, "storage.writeUserEvent event: [%s] user-id: [%s]", eventType, strconv.FormatUint(uint64(userId), 10))
+	}
+	return nil
+}
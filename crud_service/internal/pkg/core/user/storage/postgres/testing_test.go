@@ -1,10 +1,18 @@
 package postgres
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/protobuf/proto"
 )
 
 type usersTestFixture struct {
@@ -27,3 +35,61 @@ func setUp(t *testing.T) usersTestFixture {
 // func (f *usersRepoFixtures) tearDown() {
 
 // }
+
+// txMock passes queries of the transaction to the mocked pool, so they are expected in the same way
+type txMock struct {
+	pgx.Tx
+	pool *pgxpoolmock.MockPgxPool
+}
+
+func (tx *txMock) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	return tx.pool.Exec(ctx, sql, arguments...)
+}
+
+func (tx *txMock) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return tx.pool.Query(ctx, sql, args...)
+}
+
+// expectTx expects the transaction which is committed if its function succeeds
+func expectTx(pool *pgxpoolmock.MockPgxPool) {
+	pool.EXPECT().BeginFunc(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f func(pgx.Tx) error) error {
+			return f(&txMock{pool: pool})
+		})
+}
+
+// expectAuditEvent expects recording of the event made by the service itself
+func expectAuditEvent(pool *pgxpoolmock.MockPgxPool, action string, targetId uint, changes map[string]models.FieldChange) {
+	if changes == nil {
+		changes = map[string]models.FieldChange{}
+	}
+	pool.EXPECT().Exec(gomock.Any(), queryAuditInsert, uint(0), "", action, targetId, changes, "").
+		Return(pgconn.CommandTag("INSERT 0 1"), nil)
+}
+
+// expectUserEvent expects writing of the event to the outbox, the payload is compared without the time of the event
+func expectUserEvent(pool *pgxpoolmock.MockPgxPool, expected *pb.UserEvent) {
+	pool.EXPECT().Exec(gomock.Any(), queryOutboxInsert, uint(expected.GetUserId()), expected.GetType().String(), userEventPayload{expected}).
+		Return(pgconn.CommandTag("INSERT 0 1"), nil)
+}
+
+type userEventPayload struct {
+	expected *pb.UserEvent
+}
+
+func (m userEventPayload) Matches(x interface{}) bool {
+	payload, ok := x.([]byte)
+	if !ok {
+		return false
+	}
+	var event pb.UserEvent
+	if err := proto.Unmarshal(payload, &event); err != nil || event.GetOccurredAt() == nil {
+		return false
+	}
+	event.OccurredAt = nil
+	return proto.Equal(&event, m.expected)
+}
+
+func (m userEventPayload) String() string {
+	return fmt.Sprintf("is encoded event %v", m.expected)
+}
@@ -4,20 +4,33 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/driftprogramming/pgxpoolmock"
 	"github.com/georgysavva/scany/pgxscan"
-	"github.com/opentracing/opentracing-go"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/requestid"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage/postgres")
+
 const poolSize = 10
 
-var ErrUserNotExists = errors.New("user does not exists")
-var ErrRoleNotExists = errors.New("role does not exists")
-var ErrUserExists = errors.New("user already exists")
+const pgUniqueViolation = "23505"
+
+var ErrUserNotExists = storagePkg.ErrUserNotExists
+var ErrRoleNotExists = storagePkg.ErrRoleNotExists
+var ErrUserExists = storagePkg.ErrUserExists
+var ErrVersionMismatch = storagePkg.ErrVersionMismatch
 
 type Storage struct {
 	pool pgxpoolmock.PgxPool //*pgxpool.Pool
@@ -29,9 +42,9 @@ func New(pool pgxpoolmock.PgxPool) storagePkg.Interface {
 	}
 }
 
-func (s *Storage) List(ctx context.Context, recPerPage, pageNum uint64, sortingOrder models.SortingOrder) ([]models.User, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "storage/List")
-	defer span.Finish()
+func (s *Storage) List(ctx context.Context, recPerPage, pageNum uint64, sortingOrder models.SortingOrder, filter models.UserFilter) ([]models.User, error) {
+	ctx, span := tracer.Start(ctx, "storage/List")
+	defer span.End()
 
 	limit := recPerPage
 	offset := (pageNum - 1) * limit
@@ -42,19 +55,132 @@ func (s *Storage) List(ctx context.Context, recPerPage, pageNum uint64, sortingO
 		descending = "DESC"
 	}
 
-	query := fmt.Sprintf("SELECT u.id, u.email, u.full_name, r.name AS role FROM users AS u	JOIN roles AS r ON u.role = r.id ORDER BY %s %s LIMIT $1 OFFSET $2", sortingField, descending)
+	conditions, args := filterConditions(filter)
+	args = append(args, limit, offset)
+
+	query := fmt.Sprintf("SELECT u.id, u.email, u.full_name, r.name AS role, u.deleted_at, u.version FROM users AS u	JOIN roles AS r ON u.role = r.id%s ORDER BY %s %s LIMIT $%d OFFSET $%d",
+		whereClause(conditions), sortingField, descending, len(args)-1, len(args))
 
 	var result []models.User
-	if err := pgxscan.Select(ctx, s.pool, &result, query, limit, offset); err != nil {
-		span.LogKV("error", "sql error")
+	if err := pgxscan.Select(ctx, s.pool, &result, query, args...); err != nil {
+		tracing.Fail(span, err, "sql error")
 		return nil, errors.Wrap(err, "storage.List: select")
 	}
 	return result, nil
 }
 
+// ListByCursor returns the page of users following the cursor. The first page is returned if cursor is nil.
+// Unlike List it does not skip or repeat users which are added or deleted between requests of pages.
+func (s *Storage) ListByCursor(ctx context.Context, limit uint64, sortingOrder models.SortingOrder, filter models.UserFilter, after *models.Cursor) ([]models.User, error) {
+	ctx, span := tracer.Start(ctx, "storage/ListByCursor")
+	defer span.End()
+
+	sortingField := models.GetSortingFieldName(sortingOrder.Field)
+	descending, operator := "", ">"
+	if sortingOrder.Descending {
+		descending, operator = "DESC", "<"
+	}
+
+	// id is unique, so it is used as a tie-breaker for other fields
+	ordering := fmt.Sprintf("%s %s", sortingField, descending)
+	if sortingField != "u.id" {
+		ordering += fmt.Sprintf(", u.id %s", descending)
+	}
+
+	conditions, args := filterConditions(filter)
+	if after != nil {
+		if sortingField == "u.id" {
+			args = append(args, after.Id)
+			conditions = append(conditions, fmt.Sprintf("u.id %s $%d", operator, len(args)))
+		} else {
+			args = append(args, after.Value, after.Id)
+			conditions = append(conditions, fmt.Sprintf("(%s, u.id) %s ($%d, $%d)", sortingField, operator, len(args)-1, len(args)))
+		}
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf("SELECT u.id, u.email, u.full_name, r.name AS role, u.deleted_at, u.version FROM users AS u JOIN roles AS r ON u.role = r.id%s ORDER BY %s LIMIT $%d",
+		whereClause(conditions), ordering, len(args))
+
+	var result []models.User
+	if err := pgxscan.Select(ctx, s.pool, &result, query, args...); err != nil {
+		tracing.Fail(span, err, "sql error")
+		return nil, errors.Wrap(err, "storage.ListByCursor: select")
+	}
+	return result, nil
+}
+
+// Count returns number of users matching the filter
+func (s *Storage) Count(ctx context.Context, filter models.UserFilter) (uint64, error) {
+	ctx, span := tracer.Start(ctx, "storage/Count")
+	defer span.End()
+
+	conditions, args := filterConditions(filter)
+	query := "SELECT COUNT(*) FROM users AS u JOIN roles AS r ON u.role = r.id" + whereClause(conditions)
+
+	var count uint64
+	if err := pgxscan.Get(ctx, s.pool, &count, query, args...); err != nil {
+		tracing.Fail(span, err, "sql error")
+		return 0, errors.Wrap(err, "storage.Count: select")
+	}
+	return count, nil
+}
+
+// filterConditions translates the filter to SQL conditions with placeholders and their arguments.
+// User's input is passed only as arguments and never gets into the query text.
+func filterConditions(filter models.UserFilter) ([]string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if len(filter.Roles) > 0 {
+		addCondition("r.name = ANY($%d)", filter.Roles)
+	}
+	if filter.EmailContains != "" {
+		addCondition("u.email ILIKE $%d", "%"+escapeLike(filter.EmailContains)+"%")
+	}
+	if filter.EmailPrefix != "" {
+		addCondition("u.email ILIKE $%d", escapeLike(filter.EmailPrefix)+"%")
+	}
+	if filter.NameContains != "" {
+		addCondition("u.full_name ILIKE $%d", "%"+escapeLike(filter.NameContains)+"%")
+	}
+	if filter.NamePrefix != "" {
+		addCondition("u.full_name ILIKE $%d", escapeLike(filter.NamePrefix)+"%")
+	}
+	if filter.IdFrom != 0 {
+		addCondition("u.id >= $%d", filter.IdFrom)
+	}
+	if filter.IdTo != 0 {
+		addCondition("u.id <= $%d", filter.IdTo)
+	}
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, "u.deleted_at IS NULL")
+	}
+
+	return conditions, args
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes wildcards of LIKE pattern so that they are matched literally
+func escapeLike(value string) string {
+	return likeReplacer.Replace(value)
+}
+
 func (s *Storage) Add(ctx context.Context, user models.User) (uint, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "storage/Add")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "storage/Add")
+	defer span.End()
 
 	foundUser, err := s.GetUserByEmail(ctx, user.Email)
 	if err != nil && !errors.Is(err, ErrUserNotExists) {
@@ -69,88 +195,274 @@ func (s *Storage) Add(ctx context.Context, user models.User) (uint, error) {
 		return 0, errors.Wrapf(err, "storage.Add user-email: [%s] user-name: [%s]", user.Email, user.Name)
 	}
 
+	var id uint
+	err = s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		id, err = insertUser(ctx, tx, user, roleId)
+		if err != nil {
+			tracing.Fail(span, err, "sql error")
+		}
+		return err
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "storage.Add user-email: [%s] user-name: [%s]", user.Email, user.Name)
+	}
+
+	return id, nil
+}
+
+func (s *Storage) AddBatch(ctx context.Context, users []models.User) ([]uint, error) {
+	ctx, span := tracer.Start(ctx, "storage/AddBatch")
+	defer span.End()
+	span.SetAttributes(attribute.Int("users.batch_size", len(users)))
+
+	ids := make([]uint, 0, len(users))
+	roleIds := map[string]uint8{}
+	err := s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for index, user := range users {
+			roleId, ok := roleIds[user.Role]
+			if !ok {
+				var err error
+				if roleId, err = s.GetRoleIdByName(ctx, user.Role); err != nil {
+					return &storagePkg.BatchItemError{Index: index, Err: err}
+				}
+				roleIds[user.Role] = roleId
+			}
+
+			// emails taken by other users, including the previous users of the batch, violate the unique index
+			id, err := insertUser(ctx, tx, user, roleId)
+			if pgErrorCode(err) == pgUniqueViolation {
+				return &storagePkg.BatchItemError{Index: index, Err: errors.Wrapf(ErrUserExists, "user-email: [%s]", user.Email)}
+			}
+			if err != nil {
+				tracing.Fail(span, err, "sql error")
+				return &storagePkg.BatchItemError{Index: index, Err: err}
+			}
+			ids = append(ids, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "storage.AddBatch size: [%d]", len(users))
+	}
+
+	return ids, nil
+}
+
+// insertUser adds the user in the transaction together with the audit event and the outbox event
+func insertUser(ctx context.Context, tx pgx.Tx, user models.User, roleId uint8) (uint, error) {
 	query := `INSERT INTO users (email,full_name,role,password) VALUES( $1, $2, $3, $4) RETURNING id`
 
-	//row := s.pool.QueryRow(ctx, query, user.Email, user.Name, roleId, user.Password)
-	rows, err := s.pool.Query(ctx, query, user.Email, user.Name, roleId, user.Password)
+	rows, err := tx.Query(ctx, query, user.Email, user.Name, roleId, user.Password)
 	if err != nil {
-		span.LogKV("error", "sql error")
-		return 0, errors.Wrapf(err, "storage.Add user-email: [%s] user-name: [%s]", user.Email, user.Name)
+		return 0, err
 	}
 	var id uint
 	if err := pgxscan.ScanOne(&id, rows); err != nil {
-		//if err := row.Scan(&id); err != nil {
-		span.LogKV("error", "scanone error")
-		return 0, errors.Wrapf(err, "storage.Add user-email: [%s] user-name: [%s]", user.Email, user.Name)
+		return 0, err
+	}
+	changes := models.UserChanges(models.User{}, user)
+	if err := writeAuditEvent(ctx, tx, models.AuditActionCreate, id, changes); err != nil {
+		return 0, err
+	}
+	// new users get the default version of the table
+	user.Version = 1
+	if err := writeUserEvent(ctx, tx, pb.UserEvent_USER_CREATED, id, &user, changes); err != nil {
+		return 0, err
 	}
-
 	return id, nil
 }
 
-func (s *Storage) Update(ctx context.Context, user models.User) error {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "storage/Update")
-	defer span.Finish()
+func (s *Storage) Update(ctx context.Context, user models.User) (uint64, error) {
+	ctx, span := tracer.Start(ctx, "storage/Update")
+	defer span.End()
 
 	// checks that new email does not belong some other user
 	foundUser, _ := s.GetUserByEmail(ctx, user.Email)
 	if foundUser != nil && foundUser.Id != user.Id {
-		return errors.Wrapf(ErrUserExists, "user-id: [%s] user-email: [%s]", strconv.FormatUint(uint64(foundUser.Id), 10), foundUser.Email)
-	}
-
-	// checks that the user to update exists
-	foundUser, _ = s.Get(ctx, user.Id)
-	if foundUser == nil {
-		return errors.Wrapf(ErrUserNotExists, "user-id: [%s]", strconv.FormatUint(uint64(foundUser.Id), 10))
+		return 0, errors.Wrapf(ErrUserExists, "user-id: [%s] user-email: [%s]", strconv.FormatUint(uint64(foundUser.Id), 10), foundUser.Email)
 	}
 
 	roleId, err := s.GetRoleIdByName(ctx, user.Role)
 	if err != nil {
-		return errors.Wrapf(err, "storage.Add user-email: [%s] user-name: [%s]", user.Email, user.Name)
+		return 0, errors.Wrapf(err, "storage.Update user-role: [%s]", user.Role)
 	}
 
-	query := `UPDATE users SET email = $2, full_name = $3, role = $4, password = $5 WHERE id = $1`
+	// the user is locked until the end of transaction, so the version cannot be changed between the check
+	// and the update, and the audit event gets exactly the replaced values
+	queryOld := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.id = $1 AND u.deleted_at IS NULL FOR UPDATE OF u`
+	query := `UPDATE users SET email = $2, full_name = $3, role = $4, password = $5, version = version + 1 WHERE id = $1`
+
+	var version uint64
+	err = s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var old models.User
+		if err := pgxscan.Get(ctx, tx, &old, queryOld, user.Id); err != nil {
+			if pgxscan.NotFound(err) {
+				return ErrUserNotExists
+			}
+			tracing.Fail(span, err, "sql error")
+			return err
+		}
+		if user.Version != 0 && user.Version != old.Version {
+			return errors.Wrapf(ErrVersionMismatch, "expected version: [%d] actual version: [%d]", user.Version, old.Version)
+		}
 
-	result, err := s.pool.Exec(ctx, query, user.Id, user.Email, user.Name, roleId, user.Password)
+		if _, err := tx.Exec(ctx, query, user.Id, user.Email, user.Name, roleId, user.Password); err != nil {
+			tracing.Fail(span, err, "sql error")
+			return err
+		}
+		version = old.Version + 1
+		changes := models.UserChanges(old, user)
+		if err := writeAuditEvent(ctx, tx, models.AuditActionUpdate, user.Id, changes); err != nil {
+			return err
+		}
+		updated := user
+		updated.Version = version
+		return writeUserEvent(ctx, tx, pb.UserEvent_USER_UPDATED, user.Id, &updated, changes)
+	})
 	if err != nil {
-		span.LogKV("error", "sql error")
-		return errors.Wrapf(err, "storage.Update user-id: [%s]  ", strconv.FormatUint(uint64(user.Id), 10))
+		return 0, errors.Wrapf(err, "storage.Update user-id: [%s]", strconv.FormatUint(uint64(user.Id), 10))
 	}
 
+	return version, nil
+}
+
+func (s *Storage) UpgradePasswordHash(ctx context.Context, id uint, oldHash, newHash string) error {
+	ctx, span := tracer.Start(ctx, "storage/UpgradePasswordHash")
+	defer span.End()
+
+	query := `UPDATE users SET password = $3 WHERE id = $1 AND password = $2 AND deleted_at IS NULL`
+
+	result, err := s.pool.Exec(ctx, query, id, oldHash, newHash)
+	if err != nil {
+		tracing.Fail(span, err, "sql error")
+		return errors.Wrapf(err, "storage.UpgradePasswordHash user-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	}
 	if result.RowsAffected() == 0 {
-		return errors.Errorf("cannot update user. storage.Update user-id: [%s]  ", strconv.FormatUint(uint64(user.Id), 10))
+		return errors.Wrapf(ErrUserNotExists, "storage.UpgradePasswordHash user-id: [%s]", strconv.FormatUint(uint64(id), 10))
 	}
 
 	return nil
 }
 
 func (s *Storage) Delete(ctx context.Context, id uint) error {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "storage/Delete")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "storage/Delete")
+	defer span.End()
+
+	query := `UPDATE users SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+
+	if err := s.execWithAudit(ctx, models.AuditActionDelete, id, query); err != nil {
+		if !errors.Is(err, ErrUserNotExists) {
+			tracing.Fail(span, err, "sql error")
+		}
+		return errors.Wrapf(err, "storage.Delete user-id: [%s]", strconv.FormatUint(uint64(id), 10))
+	}
+
+	return nil
+}
+
+func (s *Storage) Restore(ctx context.Context, id uint) error {
+	ctx, span := tracer.Start(ctx, "storage/Restore")
+	defer span.End()
 
-	query := `DELETE FROM users WHERE id = $1`
+	query := `UPDATE users AS u SET deleted_at = NULL FROM roles AS r
+WHERE u.id = $1 AND u.deleted_at IS NOT NULL AND r.id = u.role RETURNING u.id, u.email, u.full_name, r.name AS role, u.version`
 
-	result, err := s.pool.Exec(ctx, query, id)
+	err := s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var user models.User
+		if err := pgxscan.Get(ctx, tx, &user, query, id); err != nil {
+			if pgxscan.NotFound(err) {
+				return ErrUserNotExists
+			}
+			return err
+		}
+		if err := writeAuditEvent(ctx, tx, models.AuditActionRestore, id, nil); err != nil {
+			return err
+		}
+		// consumers have dropped the user on deletion, so the event carries the whole state of the user
+		return writeUserEvent(ctx, tx, pb.UserEvent_USER_RESTORED, id, &user, nil)
+	})
 	if err != nil {
-		span.LogKV("error", "sql error")
-		return errors.Wrapf(err, "storage.Delete user-id: [%s]  ", strconv.FormatUint(uint64(id), 10))
+		// the email has been taken by another user after deletion
+		if pgErrorCode(err) == pgUniqueViolation {
+			return errors.Wrapf(ErrUserExists, "storage.Restore user-id: [%s]", strconv.FormatUint(uint64(id), 10))
+		}
+		if !errors.Is(err, ErrUserNotExists) {
+			tracing.Fail(span, err, "sql error")
+		}
+		return errors.Wrapf(err, "storage.Restore user-id: [%s]", strconv.FormatUint(uint64(id), 10))
 	}
 
-	if result.RowsAffected() == 0 {
-		return errors.Errorf("cannot delete user. storage.Update user-id: [%s]  ", strconv.FormatUint(uint64(id), 10))
+	return nil
+}
+
+func (s *Storage) Purge(ctx context.Context, id uint) error {
+	ctx, span := tracer.Start(ctx, "storage/Purge")
+	defer span.End()
+
+	query := `DELETE FROM users WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	if err := s.execWithAudit(ctx, models.AuditActionPurge, id, query); err != nil {
+		if !errors.Is(err, ErrUserNotExists) {
+			tracing.Fail(span, err, "sql error")
+		}
+		return errors.Wrapf(err, "storage.Purge user-id: [%s]", strconv.FormatUint(uint64(id), 10))
 	}
 
 	return nil
 }
 
-func (s *Storage) Get(ctx context.Context, id uint) (*models.User, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "storage/Get")
-	defer span.Finish()
+// execWithAudit runs the query changing the user with the given id and records the audit event in one transaction.
+// Deletion is published to other services through the outbox as well.
+func (s *Storage) execWithAudit(ctx context.Context, action string, id uint, query string) error {
+	return s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, query, id)
+		if err != nil {
+			return err
+		}
+		if result.RowsAffected() == 0 {
+			return ErrUserNotExists
+		}
+		if err := writeAuditEvent(ctx, tx, action, id, nil); err != nil {
+			return err
+		}
+		if action == models.AuditActionDelete {
+			return writeUserEvent(ctx, tx, pb.UserEvent_USER_DELETED, id, nil, nil)
+		}
+		return nil
+	})
+}
+
+func (s *Storage) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracer.Start(ctx, "storage/PurgeDeleted")
+	defer span.End()
+
+	// a single statement is atomic, so the users are deleted together with recording of the events
+	query := `WITH purged AS (DELETE FROM users WHERE deleted_at < $1 RETURNING id)
+INSERT INTO audit_events (actor_id, actor_email, action, target_id, request_id) SELECT $2, $3, $4, id, $5 FROM purged`
+
+	actorId, actorEmail := actor(ctx)
+	result, err := s.pool.Exec(ctx, query, before, actorId, actorEmail, models.AuditActionPurge, requestid.FromContext(ctx))
+	if err != nil {
+		tracing.Fail(span, err, "sql error")
+		return 0, errors.Wrapf(err, "storage.PurgeDeleted before: [%s]", before.Format(time.RFC3339))
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (s *Storage) Get(ctx context.Context, id uint, includeDeleted bool) (*models.User, error) {
+	ctx, span := tracer.Start(ctx, "storage/Get")
+	defer span.End()
 
-	query := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password FROM users AS u
+	query := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
 JOIN roles AS r ON u.role = r.id WHERE u.id = $1`
+	if !includeDeleted {
+		query += ` AND u.deleted_at IS NULL`
+	}
 	rows, err := s.pool.Query(ctx, query, id)
 	if err != nil {
-		span.LogKV("error", "sql error")
+		tracing.Fail(span, err, "sql error")
 		return nil, errors.Wrapf(err, "storage.Get user-id: [%s]", strconv.FormatUint(uint64(id), 10))
 	}
 	var user models.User
@@ -161,14 +473,14 @@ JOIN roles AS r ON u.role = r.id WHERE u.id = $1`
 }
 
 func (s *Storage) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "storage/GetUserByEmail")
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, "storage/GetUserByEmail")
+	defer span.End()
 
-	query := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password FROM users AS u
-JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
+	query := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.email = $1 AND u.deleted_at IS NULL`
 	rows, err := s.pool.Query(ctx, query, email)
 	if err != nil {
-		span.LogKV("error", "sql error")
+		tracing.Fail(span, err, "sql error")
 		return nil, errors.Wrapf(err, "storage.getUserbyEmail user-email: [%s]", email)
 	}
 	var user models.User
@@ -176,8 +488,16 @@ JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
 		if pgxscan.NotFound(err) {
 			return nil, errors.Wrapf(ErrUserNotExists, "storage.getUserbyEmail user-email: [%s]", email)
 		}
-		span.LogKV("error", "scanone error")
+		tracing.Fail(span, err, "scanone error")
 		return nil, errors.Wrapf(err, "storage.getUserbyEmail user-email: [%s]", email)
 	}
 	return &user, nil
 }
+
+func pgErrorCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/driftprogramming/pgxpoolmock"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
 )
 
 func TestUserAdd(t *testing.T) {
@@ -24,21 +28,34 @@ func TestUserAdd(t *testing.T) {
 
 		userStorage := New(mockPool)
 
-		queryGetUserByEmail := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password FROM users AS u
-JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
+		queryGetUserByEmail := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.email = $1 AND u.deleted_at IS NULL`
 		columns := []string{"id", "email", "full_name", "role", "password"}
 		pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
-		mockPool.EXPECT().Query(context.Background(), queryGetUserByEmail, f.data.Email).Return(pgxRows, nil).Times(1)
+		mockPool.EXPECT().Query(gomock.Any(), queryGetUserByEmail, f.data.Email).Return(pgxRows, nil).Times(1)
 
 		queryGetRolIdByName := `SELECT id FROM roles WHERE name = $1`
 		columns = []string{"id"}
 		pgxRows = pgxpoolmock.NewRows(columns).AddRow(uint8(1)).ToPgxRows()
-		mockPool.EXPECT().Query(context.Background(), queryGetRolIdByName, f.data.Role).Return(pgxRows, nil).Times(1)
+		mockPool.EXPECT().Query(gomock.Any(), queryGetRolIdByName, f.data.Role).Return(pgxRows, nil).Times(1)
 
+		expectTx(mockPool)
 		queryAdd := `INSERT INTO users (email,full_name,role,password) VALUES( $1, $2, $3, $4) RETURNING id`
 		columns = []string{"id"}
 		pgxRows = pgxpoolmock.NewRows(columns).AddRow(uint(1)).ToPgxRows()
-		mockPool.EXPECT().Query(context.Background(), queryAdd, f.data.Email, f.data.Name, uint8(1), f.data.Password).Return(pgxRows, nil).Times(1)
+		mockPool.EXPECT().Query(gomock.Any(), queryAdd, f.data.Email, f.data.Name, uint8(1), f.data.Password).Return(pgxRows, nil).Times(1)
+		expectAuditEvent(mockPool, models.AuditActionCreate, f.data.Id, map[string]models.FieldChange{
+			"email":    {New: f.data.Email},
+			"name":     {New: f.data.Name},
+			"role":     {New: f.data.Role},
+			"password": {New: models.Redacted},
+		})
+		expectUserEvent(mockPool, &pb.UserEvent{
+			Type:          pb.UserEvent_USER_CREATED,
+			UserId:        uint64(f.data.Id),
+			User:          &pb.UserEvent_User{Email: f.data.Email, Name: f.data.Name, Role: f.data.Role, Version: 1},
+			ChangedFields: []string{"email", "name", "password", "role"},
+		})
 
 		// act
 		result, err := userStorage.Add(context.Background(), models.User{
@@ -65,8 +82,8 @@ JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
 
 			userStorage := New(mockPool)
 
-			queryGetUserByEmail := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password FROM users AS u
-JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
+			queryGetUserByEmail := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.email = $1 AND u.deleted_at IS NULL`
 			columns := []string{"id", "email", "full_name", "role", "password"}
 			pgxRows := pgxpoolmock.NewRows(columns).AddRow(
 				f.data.Id,
@@ -74,7 +91,7 @@ JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
 				f.data.Name,
 				f.data.Role,
 				f.data.Password).ToPgxRows()
-			mockPool.EXPECT().Query(context.Background(), queryGetUserByEmail, f.data.Email).Return(pgxRows, nil).Times(1)
+			mockPool.EXPECT().Query(gomock.Any(), queryGetUserByEmail, f.data.Email).Return(pgxRows, nil).Times(1)
 
 			// act
 			_, err := userStorage.Add(context.Background(), models.User{
@@ -99,16 +116,16 @@ JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
 			userStorage := New(mockPool)
 			wrongRole := "wrong role"
 
-			queryGetUserByEmail := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password FROM users AS u
-JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
+			queryGetUserByEmail := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.email = $1 AND u.deleted_at IS NULL`
 			columns := []string{"id", "email", "full_name", "role", "password"}
 			pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
-			mockPool.EXPECT().Query(context.Background(), queryGetUserByEmail, f.data.Email).Return(pgxRows, nil).Times(1)
+			mockPool.EXPECT().Query(gomock.Any(), queryGetUserByEmail, f.data.Email).Return(pgxRows, nil).Times(1)
 
 			queryGetRolIdByName := `SELECT id FROM roles WHERE name = $1`
 			columns = []string{"id"}
 			pgxRows = pgxpoolmock.NewRows(columns).ToPgxRows()
-			mockPool.EXPECT().Query(context.Background(), queryGetRolIdByName, wrongRole).Return(pgxRows, nil).Times(1)
+			mockPool.EXPECT().Query(gomock.Any(), queryGetRolIdByName, wrongRole).Return(pgxRows, nil).Times(1)
 
 			// act
 			_, err := userStorage.Add(context.Background(), models.User{
@@ -134,22 +151,23 @@ JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
 
 			userStorage := New(mockPool)
 
-			queryGetUserByEmail := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password FROM users AS u
-JOIN roles AS r ON u.role = r.id WHERE u.email = $1`
+			queryGetUserByEmail := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.email = $1 AND u.deleted_at IS NULL`
 			columns := []string{"id", "email", "full_name", "role", "password"}
 			pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
-			mockPool.EXPECT().Query(context.Background(), queryGetUserByEmail, f.data.Email).Return(pgxRows, nil).Times(1)
+			mockPool.EXPECT().Query(gomock.Any(), queryGetUserByEmail, f.data.Email).Return(pgxRows, nil).Times(1)
 
 			queryGetRolIdByName := `SELECT id FROM roles WHERE name = $1`
 			columns = []string{"id"}
 			pgxRows = pgxpoolmock.NewRows(columns).AddRow(uint8(1)).ToPgxRows()
-			mockPool.EXPECT().Query(context.Background(), queryGetRolIdByName, f.data.Role).Return(pgxRows, nil).Times(1)
+			mockPool.EXPECT().Query(gomock.Any(), queryGetRolIdByName, f.data.Role).Return(pgxRows, nil).Times(1)
 
+			expectTx(mockPool)
 			queryAdd := `INSERT INTO users (email,full_name,role,password) VALUES( $1, $2, $3, $4) RETURNING id`
 			columns = []string{"id"}
 			pgxRows = pgxpoolmock.NewRows(columns).ToPgxRows()
 			mockPool.EXPECT().
-				Query(context.Background(), queryAdd, f.data.Email, f.data.Name, uint8(1), f.data.Password).
+				Query(gomock.Any(), queryAdd, f.data.Email, f.data.Name, uint8(1), f.data.Password).
 				Return(pgxRows, errors.New("db error")).Times(1)
 
 			// act
@@ -180,8 +198,8 @@ func TestUserGet(t *testing.T) {
 
 		userStorage := New(mockPool)
 
-		queryUserGet := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password FROM users AS u
-JOIN roles AS r ON u.role = r.id WHERE u.id = $1`
+		queryUserGet := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.id = $1 AND u.deleted_at IS NULL`
 		columns := []string{"id", "email", "full_name", "role", "password"}
 		pgxRows := pgxpoolmock.NewRows(columns).AddRow(
 			f.data.Id,
@@ -190,10 +208,10 @@ JOIN roles AS r ON u.role = r.id WHERE u.id = $1`
 			f.data.Role,
 			f.data.Password,
 		).ToPgxRows()
-		mockPool.EXPECT().Query(context.Background(), queryUserGet, f.data.Id).Return(pgxRows, nil) //pgx.ErrNoRows
+		mockPool.EXPECT().Query(gomock.Any(), queryUserGet, f.data.Id).Return(pgxRows, nil) //pgx.ErrNoRows
 
 		// act
-		result, err := userStorage.Get(context.Background(), f.data.Id)
+		result, err := userStorage.Get(context.Background(), f.data.Id, false)
 
 		// assert
 		require.NoError(t, err)
@@ -212,13 +230,13 @@ JOIN roles AS r ON u.role = r.id WHERE u.id = $1`
 
 			userStorage := New(mockPool)
 
-			queryUserGet := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password FROM users AS u
-JOIN roles AS r ON u.role = r.id WHERE u.id = $1`
+			queryUserGet := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.id = $1 AND u.deleted_at IS NULL`
 
-			mockPool.EXPECT().Query(context.Background(), queryUserGet, f.data.Id).Return(nil, errors.New("db error")) //pgx.ErrNoRows
+			mockPool.EXPECT().Query(gomock.Any(), queryUserGet, f.data.Id).Return(nil, errors.New("db error")) //pgx.ErrNoRows
 
 			// act
-			_, err := userStorage.Get(context.Background(), f.data.Id)
+			_, err := userStorage.Get(context.Background(), f.data.Id, false)
 
 			// assert
 			require.EqualError(t, err, fmt.Sprintf("storage.Get user-id: [%v]: db error", f.data.Id))
@@ -234,14 +252,14 @@ JOIN roles AS r ON u.role = r.id WHERE u.id = $1`
 
 			userStorage := New(mockPool)
 
-			queryUserGet := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password FROM users AS u
-JOIN roles AS r ON u.role = r.id WHERE u.id = $1`
+			queryUserGet := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.id = $1 AND u.deleted_at IS NULL`
 			columns := []string{"id", "email", "full_name", "role", "password"}
 			pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
-			mockPool.EXPECT().Query(context.Background(), queryUserGet, f.data.Id).Return(pgxRows, nil)
+			mockPool.EXPECT().Query(gomock.Any(), queryUserGet, f.data.Id).Return(pgxRows, nil)
 
 			// act
-			_, err := userStorage.Get(context.Background(), f.data.Id)
+			_, err := userStorage.Get(context.Background(), f.data.Id, false)
 
 			// assert
 			require.EqualError(t, err, fmt.Sprintf("storage.Get user-id: [%v]: user does not exists", f.data.Id))
@@ -249,3 +267,617 @@ JOIN roles AS r ON u.role = r.id WHERE u.id = $1`
 	})
 
 }
+
+func TestUserList(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		t.Run("with filter", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+			userStorage := New(mockPool)
+
+			queryList := "SELECT u.id, u.email, u.full_name, r.name AS role, u.deleted_at, u.version FROM users AS u	JOIN roles AS r ON u.role = r.id" +
+				" WHERE r.name = ANY($1) AND u.email ILIKE $2 AND u.full_name ILIKE $3 AND u.id >= $4 AND u.id <= $5 AND u.deleted_at IS NULL" +
+				" ORDER BY u.id DESC LIMIT $6 OFFSET $7"
+			columns := []string{"id", "email", "full_name", "role"}
+			pgxRows := pgxpoolmock.NewRows(columns).AddRow(
+				f.data.Id,
+				f.data.Email,
+				f.data.Name,
+				f.data.Role,
+			).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), queryList,
+				[]string{"Admin", "User"}, `%dummy\_%`, `Test\%%`, uint(1), uint(10), uint64(5), uint64(5),
+			).Return(pgxRows, nil)
+
+			// act
+			result, err := userStorage.List(context.Background(), 5, 2,
+				models.SortingOrder{Field: "id", Descending: true},
+				models.UserFilter{
+					Roles:         []string{"Admin", "User"},
+					EmailContains: "dummy_",
+					NamePrefix:    "Test%",
+					IdFrom:        1,
+					IdTo:          10,
+				})
+
+			// assert
+			require.NoError(t, err)
+			assert.Equal(t, []models.User{{
+				Id:    f.data.Id,
+				Email: f.data.Email,
+				Name:  f.data.Name,
+				Role:  f.data.Role,
+			}}, result)
+		})
+
+		t.Run("without filter", func(t *testing.T) {
+			// arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+			userStorage := New(mockPool)
+
+			queryList := "SELECT u.id, u.email, u.full_name, r.name AS role, u.deleted_at, u.version FROM users AS u	JOIN roles AS r ON u.role = r.id" +
+				" WHERE u.deleted_at IS NULL ORDER BY u.email  LIMIT $1 OFFSET $2"
+			columns := []string{"id", "email", "full_name", "role"}
+			pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), queryList, uint64(5), uint64(0)).Return(pgxRows, nil)
+
+			// act
+			result, err := userStorage.List(context.Background(), 5, 1, models.SortingOrder{Field: "email"}, models.UserFilter{})
+
+			// assert
+			require.NoError(t, err)
+			assert.Empty(t, result)
+		})
+	})
+}
+
+func TestUserListByCursor(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		t.Run("first page", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+			userStorage := New(mockPool)
+
+			queryList := "SELECT u.id, u.email, u.full_name, r.name AS role, u.deleted_at, u.version FROM users AS u JOIN roles AS r ON u.role = r.id" +
+				" WHERE u.deleted_at IS NULL ORDER BY u.id  LIMIT $1"
+			columns := []string{"id", "email", "full_name", "role"}
+			pgxRows := pgxpoolmock.NewRows(columns).AddRow(
+				f.data.Id,
+				f.data.Email,
+				f.data.Name,
+				f.data.Role,
+			).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), queryList, uint64(5)).Return(pgxRows, nil)
+
+			// act
+			result, err := userStorage.ListByCursor(context.Background(), 5, models.SortingOrder{Field: "id"}, models.UserFilter{}, nil)
+
+			// assert
+			require.NoError(t, err)
+			assert.Len(t, result, 1)
+		})
+
+		t.Run("next page", func(t *testing.T) {
+			// arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+			userStorage := New(mockPool)
+
+			queryList := "SELECT u.id, u.email, u.full_name, r.name AS role, u.deleted_at, u.version FROM users AS u JOIN roles AS r ON u.role = r.id" +
+				" WHERE r.name = ANY($1) AND u.deleted_at IS NULL AND (u.email, u.id) < ($2, $3) ORDER BY u.email DESC, u.id DESC LIMIT $4"
+			columns := []string{"id", "email", "full_name", "role"}
+			pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), queryList, []string{"Admin"}, "test01@dummy.com", uint(7), uint64(5)).Return(pgxRows, nil)
+
+			sortingOrder := models.SortingOrder{Field: "email", Descending: true}
+
+			// act
+			result, err := userStorage.ListByCursor(context.Background(), 5, sortingOrder,
+				models.UserFilter{Roles: []string{"Admin"}},
+				&models.Cursor{SortingOrder: sortingOrder, Value: "test01@dummy.com", Id: 7},
+			)
+
+			// assert
+			require.NoError(t, err)
+			assert.Empty(t, result)
+		})
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		// act
+		_, err := userStorage.ListByCursor(context.Background(), 5, models.SortingOrder{Field: "id"}, models.UserFilter{}, nil)
+
+		// assert
+		require.EqualError(t, err, "storage.ListByCursor: select: scany: query multiple result rows: db error")
+	})
+}
+
+func TestUserCount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		queryCount := "SELECT COUNT(*) FROM users AS u JOIN roles AS r ON u.role = r.id WHERE r.name = ANY($1) AND u.deleted_at IS NULL"
+		columns := []string{"count"}
+		pgxRows := pgxpoolmock.NewRows(columns).AddRow(uint64(17)).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), queryCount, []string{"User"}).Return(pgxRows, nil)
+
+		// act
+		result, err := userStorage.Count(context.Background(), models.UserFilter{Roles: []string{"User"}})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, uint64(17), result)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		queryCount := "SELECT COUNT(*) FROM users AS u JOIN roles AS r ON u.role = r.id WHERE u.deleted_at IS NULL"
+		mockPool.EXPECT().Query(gomock.Any(), queryCount).Return(nil, errors.New("db error"))
+
+		// act
+		_, err := userStorage.Count(context.Background(), models.UserFilter{})
+
+		// assert
+		require.EqualError(t, err, "storage.Count: select: scany: query one result row: db error")
+	})
+}
+
+func TestUserDelete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		expectTx(mockPool)
+		queryDelete := `UPDATE users SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+		mockPool.EXPECT().Exec(gomock.Any(), queryDelete, f.data.Id).Return(pgconn.CommandTag("UPDATE 1"), nil)
+		expectAuditEvent(mockPool, models.AuditActionDelete, f.data.Id, nil)
+		expectUserEvent(mockPool, &pb.UserEvent{
+			Type:   pb.UserEvent_USER_DELETED,
+			UserId: uint64(f.data.Id),
+		})
+
+		// act
+		err := userStorage.Delete(context.Background(), f.data.Id)
+
+		// assert
+		require.NoError(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		expectTx(mockPool)
+		mockPool.EXPECT().Exec(gomock.Any(), gomock.Any(), f.data.Id).Return(pgconn.CommandTag("UPDATE 0"), nil)
+
+		// act
+		err := userStorage.Delete(context.Background(), f.data.Id)
+
+		// assert
+		require.ErrorIs(t, err, ErrUserNotExists)
+	})
+}
+
+func TestUserUpgradePasswordHash(t *testing.T) {
+	query := `UPDATE users SET password = $3 WHERE id = $1 AND password = $2 AND deleted_at IS NULL`
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		// neither the version nor events are changed, so no transaction is needed
+		mockPool.EXPECT().Exec(gomock.Any(), query, f.data.Id, "legacy", "new").Return(pgconn.CommandTag("UPDATE 1"), nil)
+
+		// act
+		err := userStorage.UpgradePasswordHash(context.Background(), f.data.Id, "legacy", "new")
+
+		// assert
+		require.NoError(t, err)
+	})
+
+	t.Run("password changed concurrently", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		mockPool.EXPECT().Exec(gomock.Any(), query, f.data.Id, "legacy", "new").Return(pgconn.CommandTag("UPDATE 0"), nil)
+
+		// act
+		err := userStorage.UpgradePasswordHash(context.Background(), f.data.Id, "legacy", "new")
+
+		// assert
+		require.ErrorIs(t, err, ErrUserNotExists)
+	})
+}
+
+func TestUserRestore(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		expectTx(mockPool)
+		queryRestore := `UPDATE users AS u SET deleted_at = NULL FROM roles AS r
+WHERE u.id = $1 AND u.deleted_at IS NOT NULL AND r.id = u.role RETURNING u.id, u.email, u.full_name, r.name AS role, u.version`
+		pgxRows := pgxpoolmock.NewRows([]string{"id", "email", "full_name", "role", "version"}).
+			AddRow(f.data.Id, f.data.Email, f.data.Name, f.data.Role, uint64(3)).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), queryRestore, f.data.Id).Return(pgxRows, nil)
+		expectAuditEvent(mockPool, models.AuditActionRestore, f.data.Id, nil)
+		expectUserEvent(mockPool, &pb.UserEvent{
+			Type:   pb.UserEvent_USER_RESTORED,
+			UserId: uint64(f.data.Id),
+			User: &pb.UserEvent_User{
+				Email:   f.data.Email,
+				Name:    f.data.Name,
+				Role:    f.data.Role,
+				Version: 3,
+			},
+		})
+
+		// act
+		err := userStorage.Restore(context.Background(), f.data.Id)
+
+		// assert
+		require.NoError(t, err)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("user is not deleted", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+			userStorage := New(mockPool)
+
+			expectTx(mockPool)
+			pgxRows := pgxpoolmock.NewRows([]string{"id", "email", "full_name", "role", "version"}).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Id).Return(pgxRows, nil)
+
+			// act
+			err := userStorage.Restore(context.Background(), f.data.Id)
+
+			// assert
+			require.ErrorIs(t, err, ErrUserNotExists)
+		})
+
+		t.Run("email is taken", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+			userStorage := New(mockPool)
+
+			expectTx(mockPool)
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Id).Return(nil, &pgconn.PgError{Code: pgUniqueViolation})
+
+			// act
+			err := userStorage.Restore(context.Background(), f.data.Id)
+
+			// assert
+			require.ErrorIs(t, err, ErrUserExists)
+		})
+	})
+}
+
+func TestUserPurgeDeleted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		before := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+		queryPurge := `WITH purged AS (DELETE FROM users WHERE deleted_at < $1 RETURNING id)
+INSERT INTO audit_events (actor_id, actor_email, action, target_id, request_id) SELECT $2, $3, $4, id, $5 FROM purged`
+		mockPool.EXPECT().Exec(gomock.Any(), queryPurge, before, uint(0), "", models.AuditActionPurge, "").
+			Return(pgconn.CommandTag("INSERT 0 3"), nil)
+
+		// act
+		result, err := userStorage.PurgeDeleted(context.Background(), before)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), result)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		mockPool.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("db error"))
+
+		// act
+		_, err := userStorage.PurgeDeleted(context.Background(), time.Now())
+
+		// assert
+		require.Error(t, err)
+	})
+}
+
+func TestUserUpdate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+		newName := "New Name"
+
+		queryGetUserByEmail := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.deleted_at, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.email = $1 AND u.deleted_at IS NULL`
+		columns := []string{"id", "email", "full_name", "role", "password"}
+		pgxRows := pgxpoolmock.NewRows(columns).AddRow(f.data.Id, f.data.Email, f.data.Name, f.data.Role, f.data.Password).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), queryGetUserByEmail, f.data.Email).Return(pgxRows, nil)
+
+		queryGetRolIdByName := `SELECT id FROM roles WHERE name = $1`
+		pgxRows = pgxpoolmock.NewRows([]string{"id"}).AddRow(uint8(1)).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), queryGetRolIdByName, f.data.Role).Return(pgxRows, nil)
+
+		expectTx(mockPool)
+		queryOld := `SELECT u.id, u.email, u.full_name, r.name AS role, u.password, u.version FROM users AS u
+JOIN roles AS r ON u.role = r.id WHERE u.id = $1 AND u.deleted_at IS NULL FOR UPDATE OF u`
+		pgxRows = pgxpoolmock.NewRows(append(columns, "version")).AddRow(f.data.Id, f.data.Email, f.data.Name, f.data.Role, f.data.Password, uint64(3)).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), queryOld, f.data.Id).Return(pgxRows, nil)
+
+		queryUpdate := `UPDATE users SET email = $2, full_name = $3, role = $4, password = $5, version = version + 1 WHERE id = $1`
+		mockPool.EXPECT().Exec(gomock.Any(), queryUpdate, f.data.Id, f.data.Email, newName, uint8(1), "new hash").
+			Return(pgconn.CommandTag("UPDATE 1"), nil)
+		expectAuditEvent(mockPool, models.AuditActionUpdate, f.data.Id, map[string]models.FieldChange{
+			"name":     {Old: f.data.Name, New: newName},
+			"password": {Old: models.Redacted, New: models.Redacted},
+		})
+		expectUserEvent(mockPool, &pb.UserEvent{
+			Type:          pb.UserEvent_USER_UPDATED,
+			UserId:        uint64(f.data.Id),
+			User:          &pb.UserEvent_User{Email: f.data.Email, Name: newName, Role: f.data.Role, Version: 4},
+			ChangedFields: []string{"name", "password"},
+		})
+
+		// act
+		version, err := userStorage.Update(context.Background(), models.User{
+			Id:       f.data.Id,
+			Email:    f.data.Email,
+			Name:     newName,
+			Role:     f.data.Role,
+			Password: "new hash",
+			Version:  3,
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, uint64(4), version)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("user does not exist", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+			userStorage := New(mockPool)
+
+			columns := []string{"id", "email", "full_name", "role", "password"}
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Email).Return(pgxpoolmock.NewRows(columns).ToPgxRows(), nil)
+			pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(uint8(1)).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Role).Return(pgxRows, nil)
+
+			expectTx(mockPool)
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Id).Return(pgxpoolmock.NewRows(columns).ToPgxRows(), nil)
+
+			// act
+			_, err := userStorage.Update(context.Background(), f.data)
+
+			// assert
+			require.ErrorIs(t, err, ErrUserNotExists)
+		})
+
+		t.Run("version mismatch", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+			userStorage := New(mockPool)
+
+			columns := []string{"id", "email", "full_name", "role", "password"}
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Email).Return(pgxpoolmock.NewRows(columns).ToPgxRows(), nil)
+			pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(uint8(1)).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Role).Return(pgxRows, nil)
+
+			expectTx(mockPool)
+			pgxRows = pgxpoolmock.NewRows(append(columns, "version")).AddRow(f.data.Id, f.data.Email, f.data.Name, f.data.Role, f.data.Password, uint64(4)).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Id).Return(pgxRows, nil)
+
+			// act
+			f.data.Version = 3
+			_, err := userStorage.Update(context.Background(), f.data)
+
+			// assert
+			require.ErrorIs(t, err, ErrVersionMismatch)
+		})
+
+		t.Run("wrong role", func(t *testing.T) {
+			// arrange
+			f := setUp(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+			userStorage := New(mockPool)
+			f.data.Role = "wrong role"
+
+			columns := []string{"id", "email", "full_name", "role", "password"}
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Email).Return(pgxpoolmock.NewRows(columns).ToPgxRows(), nil)
+			pgxRows := pgxpoolmock.NewRows([]string{"id"}).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), gomock.Any(), f.data.Role).Return(pgxRows, nil)
+
+			// act
+			_, err := userStorage.Update(context.Background(), f.data)
+
+			// assert
+			require.EqualError(t, err,
+				fmt.Sprintf("storage.Update user-role: [%v]: storage.getRoleByName role: [%v]: role does not exists", f.data.Role, f.data.Role))
+		})
+	})
+}
+
+func TestUserAddBatch(t *testing.T) {
+	queryGetRolIdByName := `SELECT id FROM roles WHERE name = $1`
+	queryAdd := `INSERT INTO users (email,full_name,role,password) VALUES( $1, $2, $3, $4) RETURNING id`
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+		second := f.data
+		second.Email = "test02@dummy.com"
+
+		expectTx(mockPool)
+		pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(uint8(1)).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), queryGetRolIdByName, f.data.Role).Return(pgxRows, nil).Times(1)
+		for id, user := range []models.User{f.data, second} {
+			pgxRows = pgxpoolmock.NewRows([]string{"id"}).AddRow(uint(id + 1)).ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), queryAdd, user.Email, user.Name, uint8(1), user.Password).Return(pgxRows, nil).Times(1)
+			expectAuditEvent(mockPool, models.AuditActionCreate, uint(id+1), map[string]models.FieldChange{
+				"email":    {New: user.Email},
+				"name":     {New: user.Name},
+				"role":     {New: user.Role},
+				"password": {New: models.Redacted},
+			})
+			expectUserEvent(mockPool, &pb.UserEvent{
+				Type:          pb.UserEvent_USER_CREATED,
+				UserId:        uint64(id + 1),
+				User:          &pb.UserEvent_User{Email: user.Email, Name: user.Name, Role: user.Role, Version: 1},
+				ChangedFields: []string{"email", "name", "password", "role"},
+			})
+		}
+
+		// act
+		result, err := userStorage.AddBatch(context.Background(), []models.User{f.data, second})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, []uint{1, 2}, result)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		f := setUp(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+
+		userStorage := New(mockPool)
+
+		expectTx(mockPool)
+		pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(uint8(1)).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), queryGetRolIdByName, f.data.Role).Return(pgxRows, nil).Times(1)
+		pgxRows = pgxpoolmock.NewRows([]string{"id"}).AddRow(uint(1)).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), queryAdd, f.data.Email, f.data.Name, uint8(1), f.data.Password).Return(pgxRows, nil).Times(1)
+		mockPool.EXPECT().Exec(gomock.Any(), queryAuditInsert, gomock.Any()).Return(pgconn.CommandTag("INSERT 0 1"), nil).Times(1)
+		mockPool.EXPECT().Exec(gomock.Any(), queryOutboxInsert, gomock.Any()).Return(pgconn.CommandTag("INSERT 0 1"), nil).Times(1)
+		mockPool.EXPECT().Query(gomock.Any(), queryAdd, f.data.Email, f.data.Name, uint8(1), f.data.Password).
+			Return(nil, &pgconn.PgError{Code: pgUniqueViolation}).Times(1)
+
+		// act
+		result, err := userStorage.AddBatch(context.Background(), []models.User{f.data, f.data})
+
+		// assert
+		var itemErr *storagePkg.BatchItemError
+		require.ErrorAs(t, err, &itemErr)
+		assert.Equal(t, 1, itemErr.Index)
+		assert.ErrorIs(t, err, ErrUserExists)
+		assert.Nil(t, result)
+	})
+}
@@ -5,15 +5,55 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/pkg/errors"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 )
 
+// Errors returned by all implementations of the storage, callers match them by errors.Is
+var ErrUserNotExists = errors.New("user does not exists")
+var ErrRoleNotExists = errors.New("role does not exists")
+var ErrUserExists = errors.New("user already exists")
+var ErrVersionMismatch = errors.New("user has been changed since it was read")
+
 type Interface interface {
 	Add(ctx context.Context, user models.User) (uint, error)
+	// AddBatch adds all users or none of them. Failure of a user is returned as *BatchItemError.
+	AddBatch(ctx context.Context, users []models.User) ([]uint, error)
+	// Delete marks the user as deleted, the user can be restored until it is purged
 	Delete(ctx context.Context, id uint) error
-	Get(ctx context.Context, id uint) (*models.User, error)
-	Update(ctx context.Context, user models.User) error
-	List(ctx context.Context, recPerPage, pageNum uint64, sortingOrder models.SortingOrder) ([]models.User, error)
+	Restore(ctx context.Context, id uint) error
+	// Purge removes the soft deleted user permanently
+	Purge(ctx context.Context, id uint) error
+	// PurgeDeleted removes permanently all users deleted before the given time and returns their number
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+	Get(ctx context.Context, id uint, includeDeleted bool) (*models.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
+	// Update replaces the user and returns its new version. The update fails with ErrVersionMismatch
+	// if the version of the user is set and the stored user has another one.
+	Update(ctx context.Context, user models.User) (uint64, error)
+	// UpgradePasswordHash replaces the hash of the same password made by a legacy algorithm. The user is not
+	// changed for clients, so the version is kept and no events are recorded. The hash is replaced only
+	// if it is still oldHash, otherwise ErrUserNotExists is returned, so a concurrent change of the password is not lost.
+	UpgradePasswordHash(ctx context.Context, id uint, oldHash, newHash string) error
+	List(ctx context.Context, recPerPage, pageNum uint64, sortingOrder models.SortingOrder, filter models.UserFilter) ([]models.User, error)
+	ListByCursor(ctx context.Context, limit uint64, sortingOrder models.SortingOrder, filter models.UserFilter, after *models.Cursor) ([]models.User, error)
+	Count(ctx context.Context, filter models.UserFilter) (uint64, error)
 	GetRoleIdByName(ctx context.Context, role string) (uint8, error)
 }
+
+// BatchItemError points to the user of the batch which has not been added
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("batch item [%d]: %v", e.Index, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
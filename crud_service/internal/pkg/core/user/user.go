@@ -3,7 +3,9 @@
 package user
 
 import (
-	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+
+	"github.com/driftprogramming/pgxpoolmock"
 	"gitlab.ozon.dev/vldem/homework1/internal/config"
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 	storagePkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/storage"
@@ -13,10 +15,20 @@ import (
 
 type Interface interface {
 	Create(ctx context.Context, user models.User) (uint, error)
-	Update(ctx context.Context, user models.User) error
+	// CreateBatch creates all users or none of them
+	CreateBatch(ctx context.Context, users []models.User) ([]uint, error)
+	Update(ctx context.Context, user models.User) (uint64, error)
+	// UpgradePasswordHash replaces the legacy hash of the password keeping the version of the user
+	UpgradePasswordHash(ctx context.Context, id uint, oldHash, newHash string) error
 	Delete(ctx context.Context, id uint) error
-	Get(ctx context.Context, id uint) (*models.User, error)
-	List(ctx context.Context, recPerPage uint64, pageNum uint64, sortingOrder models.SortingOrder) ([]models.User, error)
+	Restore(ctx context.Context, id uint) error
+	Purge(ctx context.Context, id uint) error
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+	Get(ctx context.Context, id uint, includeDeleted bool) (*models.User, error)
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	List(ctx context.Context, recPerPage uint64, pageNum uint64, sortingOrder models.SortingOrder, filter models.UserFilter) ([]models.User, error)
+	ListByCursor(ctx context.Context, limit uint64, sortingOrder models.SortingOrder, filter models.UserFilter, after *models.Cursor) ([]models.User, error)
+	Count(ctx context.Context, filter models.UserFilter) (uint64, error)
 	GetRoleIdByName(ctx context.Context, roleName string) (uint8, error)
 }
 
@@ -24,7 +36,7 @@ type core struct {
 	storage storagePkg.Interface
 }
 
-func New(pool *pgxpool.Pool) Interface {
+func New(pool pgxpoolmock.PgxPool) Interface {
 	return &core{
 		//storage: localStoragePkg.New(),
 		storage: postgresStoragePkg.New(pool),
@@ -53,16 +65,60 @@ func (c *core) Create(ctx context.Context, user models.User) (uint, error) {
 	return id, err
 }
 
-func (c *core) Update(ctx context.Context, user models.User) error {
+func (c *core) CreateBatch(ctx context.Context, users []models.User) ([]uint, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var ids []uint
+	var err error
+
+	go func(ch chan struct{}) {
+		ids, err = c.storage.AddBatch(ctx, users)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return ids, err
+}
+
+func (c *core) Update(ctx context.Context, user models.User) (uint64, error) {
 	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
 	defer cancel()
 
 	timeOutCh := make(chan struct{}, 1)
 
+	var version uint64
 	var err error
 
 	go func(ch chan struct{}) {
-		err = c.storage.Update(ctx, user)
+		version, err = c.storage.Update(ctx, user)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return version, err
+}
+
+func (c *core) UpgradePasswordHash(ctx context.Context, id uint, oldHash, newHash string) error {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+
+	timeOutCh := make(chan struct{}, 1)
+	var err error
+
+	go func(ch chan struct{}) {
+		err = c.storage.UpgradePasswordHash(ctx, id, oldHash, newHash)
 		ch <- struct{}{}
 	}(timeOutCh)
 
@@ -96,7 +152,92 @@ func (c *core) Delete(ctx context.Context, id uint) error {
 	return err
 }
 
-func (c *core) Get(ctx context.Context, id uint) (*models.User, error) {
+func (c *core) Restore(ctx context.Context, id uint) error {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+
+	timeOutCh := make(chan struct{}, 1)
+	var err error
+
+	go func(ch chan struct{}) {
+		err = c.storage.Restore(ctx, id)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return err
+}
+
+func (c *core) Purge(ctx context.Context, id uint) error {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+
+	timeOutCh := make(chan struct{}, 1)
+	var err error
+
+	go func(ch chan struct{}) {
+		err = c.storage.Purge(ctx, id)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return err
+}
+
+func (c *core) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+
+	timeOutCh := make(chan struct{}, 1)
+	var count int64
+	var err error
+
+	go func(ch chan struct{}) {
+		count, err = c.storage.PurgeDeleted(ctx, before)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return count, err
+}
+
+func (c *core) Get(ctx context.Context, id uint, includeDeleted bool) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var result *models.User
+	var err error
+
+	go func(ch chan struct{}) {
+		result, err = c.storage.Get(ctx, id, includeDeleted)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeOutCh:
+	}
+	return result, err
+}
+
+func (c *core) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
 	defer cancel()
 	timeOutCh := make(chan struct{}, 1)
@@ -105,7 +246,7 @@ func (c *core) Get(ctx context.Context, id uint) (*models.User, error) {
 	var err error
 
 	go func(ch chan struct{}) {
-		result, err = c.storage.Get(ctx, id)
+		result, err = c.storage.GetUserByEmail(ctx, email)
 		ch <- struct{}{}
 	}(timeOutCh)
 
@@ -117,7 +258,7 @@ func (c *core) Get(ctx context.Context, id uint) (*models.User, error) {
 	return result, err
 }
 
-func (c *core) List(ctx context.Context, recPerPage uint64, pageNum uint64, sortingOrder models.SortingOrder) ([]models.User, error) {
+func (c *core) List(ctx context.Context, recPerPage uint64, pageNum uint64, sortingOrder models.SortingOrder, filter models.UserFilter) ([]models.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
 	defer cancel()
 	timeOutCh := make(chan struct{}, 1)
@@ -126,7 +267,7 @@ func (c *core) List(ctx context.Context, recPerPage uint64, pageNum uint64, sort
 	var err error
 
 	go func(ch chan struct{}) {
-		result, err = c.storage.List(ctx, recPerPage, pageNum, sortingOrder)
+		result, err = c.storage.List(ctx, recPerPage, pageNum, sortingOrder, filter)
 		ch <- struct{}{}
 	}(timeOutCh)
 
@@ -139,6 +280,50 @@ func (c *core) List(ctx context.Context, recPerPage uint64, pageNum uint64, sort
 	return result, err
 }
 
+func (c *core) ListByCursor(ctx context.Context, limit uint64, sortingOrder models.SortingOrder, filter models.UserFilter, after *models.Cursor) ([]models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var result []models.User
+	var err error
+
+	go func(ch chan struct{}) {
+		result, err = c.storage.ListByCursor(ctx, limit, sortingOrder, filter, after)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return result, err
+}
+
+func (c *core) Count(ctx context.Context, filter models.UserFilter) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
+	defer cancel()
+	timeOutCh := make(chan struct{}, 1)
+
+	var result uint64
+	var err error
+
+	go func(ch chan struct{}) {
+		result, err = c.storage.Count(ctx, filter)
+		ch <- struct{}{}
+	}(timeOutCh)
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-timeOutCh:
+	}
+
+	return result, err
+}
+
 func (c *core) GetRoleIdByName(ctx context.Context, roleName string) (uint8, error) {
 	ctx, cancel := context.WithTimeout(ctx, config.ShortDuration)
 	defer cancel()
@@ -5,6 +5,7 @@ package validator
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 
 	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 
@@ -13,6 +14,20 @@ import (
 
 var ErrInvalidData = errors.New("invalid data")
 
+const maxFilterValueLen = 255
+
+// RoleRegistry knows which roles exist
+type RoleRegistry interface {
+	Exists(role string) bool
+}
+
+// roles must be set by the service before validation of roles. Until then any role is rejected.
+var roles RoleRegistry
+
+func SetRoleRegistry(registry RoleRegistry) {
+	roles = registry
+}
+
 type ValidationHandler func(string) error
 
 type Validator struct {
@@ -101,13 +116,36 @@ func ValidateName(name string) error {
 }
 
 func ValidateRole(role string) error {
-	if models.GetRoleId(role) == 0 {
+	if roles == nil || !roles.Exists(role) {
 		return fmt.Errorf("bad role <%v>", role)
 	}
 
 	return nil
 }
 
+func ValidateRoleId(id string) error {
+	roleId, err := strconv.ParseUint(id, 10, 8)
+	if err != nil || roleId == 0 {
+		return fmt.Errorf("bad role id <%v>", id)
+	}
+
+	return nil
+}
+
+func ValidateRoleName(name string) error {
+	matched, err := regexp.MatchString(`^[a-zA-Z0-9\-\_]{2,30}$`, name)
+
+	if err != nil {
+		return errors.Wrap(err, "role name validator")
+	}
+
+	if !matched {
+		return fmt.Errorf("bad role name <%v> (should contain <a-zA-Z0-9-_> length 2-30 symbols)", name)
+	}
+
+	return nil
+}
+
 func ValidateSortingField(field string) error {
 	matched, err := regexp.MatchString(`^[a-z]{2,5}$`, field)
 	if err != nil {
@@ -124,6 +162,40 @@ func ValidateSortingField(field string) error {
 	return nil
 }
 
+func ValidateUserFilter(filter models.UserFilter) error {
+	for _, role := range filter.Roles {
+		if err := ValidateRole(role); err != nil {
+			return err
+		}
+	}
+
+	for _, value := range []string{filter.EmailContains, filter.EmailPrefix, filter.NameContains, filter.NamePrefix} {
+		if len(value) > maxFilterValueLen {
+			return fmt.Errorf("bad filter value <%v> (should be not longer than %v symbols)", value, maxFilterValueLen)
+		}
+	}
+
+	if filter.IdFrom != 0 && filter.IdTo != 0 && filter.IdFrom > filter.IdTo {
+		return fmt.Errorf("bad id range <%v-%v>", filter.IdFrom, filter.IdTo)
+	}
+
+	return nil
+}
+
+func ValidateAuditFilter(filter models.AuditFilter) error {
+	for _, action := range filter.Actions {
+		if !models.IsAuditAction(action) {
+			return fmt.Errorf("bad audit action <%v>", action)
+		}
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return fmt.Errorf("bad time range <%v-%v>", filter.From, filter.To)
+	}
+
+	return nil
+}
+
 func ValidateParameters(data map[string]string) error {
 	if len(data) == 0 {
 		return fmt.Errorf("empty parameters to validate")
@@ -139,3 +211,18 @@ func ValidateParameters(data map[string]string) error {
 
 	return nil
 }
+
+// ValidateUpdateMask checks that every path of the mask is a field of the user which can be changed
+func ValidateUpdateMask(paths []string) error {
+	if len(paths) == 0 {
+		return fmt.Errorf("empty update mask")
+	}
+
+	for _, path := range paths {
+		if _, ok := validator.rules[path]; !ok {
+			return fmt.Errorf("bad update mask path <%v>", path)
+		}
+	}
+
+	return nil
+}
@@ -12,8 +12,12 @@ var cInRequest *counter
 var cOutRequest *counter
 var cSuccessRequest *counter
 var cFailedRequest *counter
-var cCacheMis *counter
-var cCacheHit *counter
+
+// cache counters are kept per family of keys, e.g. UserGet, UserList
+var mCacheMiss *expvar.Map
+var mCacheHit *expvar.Map
+var mCacheNegativeHit *expvar.Map
+var mCacheCoalesced *expvar.Map
 
 type counter struct {
 	cnt int
@@ -47,11 +51,21 @@ func SuccessRequestInc() {
 func FailedRequestInc() {
 	cFailedRequest.Inc()
 }
-func CacheMisInc() {
-	cCacheMis.Inc()
+func CacheMissInc(family string) {
+	mCacheMiss.Add(family, 1)
+}
+func CacheHitInc(family string) {
+	mCacheHit.Add(family, 1)
 }
-func CacheHitInc() {
-	cCacheHit.Inc()
+
+// CacheNegativeHitInc counts hits of cached absence of values
+func CacheNegativeHitInc(family string) {
+	mCacheNegativeHit.Add(family, 1)
+}
+
+// CacheCoalescedInc counts misses which have waited for the value loaded by a concurrent request
+func CacheCoalescedInc(family string) {
+	mCacheCoalesced.Add(family, 1)
 }
 
 type Goroutines struct {
@@ -67,15 +81,15 @@ func init() {
 	cOutRequest = &counter{m: &sync.RWMutex{}}
 	cSuccessRequest = &counter{m: &sync.RWMutex{}}
 	cFailedRequest = &counter{m: &sync.RWMutex{}}
-	cCacheMis = &counter{m: &sync.RWMutex{}}
-	cCacheHit = &counter{m: &sync.RWMutex{}}
 	expvar.Publish("Errors", cErr)
 	expvar.Publish("In requests", cInRequest)
 	expvar.Publish("Out requests", cOutRequest)
 	expvar.Publish("Success requests", cSuccessRequest)
 	expvar.Publish("Failed requests", cFailedRequest)
-	expvar.Publish("Cache miss", cCacheMis)
-	expvar.Publish("Cache hit", cCacheHit)
+	mCacheMiss = expvar.NewMap("Cache miss")
+	mCacheHit = expvar.NewMap("Cache hit")
+	mCacheNegativeHit = expvar.NewMap("Cache negative hit")
+	mCacheCoalesced = expvar.NewMap("Cache coalesced")
 	g := &Goroutines{}
 	expvar.Publish("Goroutines", g)
 }
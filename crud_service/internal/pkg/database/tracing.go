@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"strings"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/pkg/database")
+
+// Trace returns the pool starting the span of every query, queries of transactions are traced too.
+// The span of the query returning rows ends when the rows are closed.
+func Trace(pool pgxpoolmock.PgxPool) pgxpoolmock.PgxPool {
+	return &tracedPool{PgxPool: pool}
+}
+
+type tracedPool struct {
+	pgxpoolmock.PgxPool
+}
+
+func (p *tracedPool) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	ctx, span := startSpan(ctx, sql)
+	defer span.End()
+
+	tag, err := p.PgxPool.Exec(ctx, sql, arguments...)
+	if err != nil {
+		tracing.Fail(span, err, "sql error")
+	}
+	return tag, err
+}
+
+func (p *tracedPool) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, span := startSpan(ctx, sql)
+	rows, err := p.PgxPool.Query(ctx, sql, args...)
+	if err != nil {
+		tracing.Fail(span, err, "sql error")
+		span.End()
+		return nil, err
+	}
+	return &tracedRows{Rows: rows, span: span}, nil
+}
+
+func (p *tracedPool) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx, span := startSpan(ctx, sql)
+	return &tracedRow{row: p.PgxPool.QueryRow(ctx, sql, args...), span: span}
+}
+
+func (p *tracedPool) QueryFunc(ctx context.Context, sql string, args []interface{}, scans []interface{}, f func(pgx.QueryFuncRow) error) (pgconn.CommandTag, error) {
+	ctx, span := startSpan(ctx, sql)
+	defer span.End()
+
+	tag, err := p.PgxPool.QueryFunc(ctx, sql, args, scans, f)
+	if err != nil {
+		tracing.Fail(span, err, "sql error")
+	}
+	return tag, err
+}
+
+func (p *tracedPool) BeginFunc(ctx context.Context, f func(pgx.Tx) error) error {
+	return p.BeginTxFunc(ctx, pgx.TxOptions{}, f)
+}
+
+func (p *tracedPool) BeginTxFunc(ctx context.Context, txOptions pgx.TxOptions, f func(pgx.Tx) error) error {
+	ctx, span := startSpan(ctx, "BEGIN")
+	defer span.End()
+
+	err := p.PgxPool.BeginTxFunc(ctx, txOptions, func(tx pgx.Tx) error {
+		return f(&tracedTx{Tx: tx, span: span})
+	})
+	if err != nil {
+		tracing.Fail(span, err, "transaction error")
+	}
+	return err
+}
+
+func (p *tracedPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	return p.BeginTx(ctx, pgx.TxOptions{})
+}
+
+func (p *tracedPool) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	tx, err := p.PgxPool.BeginTx(ctx, txOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedTx{Tx: tx}, nil
+}
+
+// tracedTx traces queries of the transaction, they are children of the span of the transaction if it is set
+type tracedTx struct {
+	pgx.Tx
+	span trace.Span
+}
+
+func (t *tracedTx) startSpan(ctx context.Context, sql string) (context.Context, trace.Span) {
+	if t.span != nil {
+		ctx = trace.ContextWithSpan(ctx, t.span)
+	}
+	return startSpan(ctx, sql)
+}
+
+func (t *tracedTx) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	ctx, span := t.startSpan(ctx, sql)
+	defer span.End()
+
+	tag, err := t.Tx.Exec(ctx, sql, arguments...)
+	if err != nil {
+		tracing.Fail(span, err, "sql error")
+	}
+	return tag, err
+}
+
+func (t *tracedTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, span := t.startSpan(ctx, sql)
+	rows, err := t.Tx.Query(ctx, sql, args...)
+	if err != nil {
+		tracing.Fail(span, err, "sql error")
+		span.End()
+		return nil, err
+	}
+	return &tracedRows{Rows: rows, span: span}, nil
+}
+
+func (t *tracedTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx, span := t.startSpan(ctx, sql)
+	return &tracedRow{row: t.Tx.QueryRow(ctx, sql, args...), span: span}
+}
+
+// tracedRows ends the span of the query when rows are read
+type tracedRows struct {
+	pgx.Rows
+	span trace.Span
+}
+
+func (r *tracedRows) Close() {
+	r.Rows.Close()
+	if err := r.Rows.Err(); err != nil {
+		tracing.Fail(r.span, err, "sql error")
+	}
+	r.span.End()
+}
+
+// tracedRow ends the span of the query when the row is scanned
+type tracedRow struct {
+	row  pgx.Row
+	span trace.Span
+}
+
+func (r *tracedRow) Scan(dest ...interface{}) error {
+	defer r.span.End()
+
+	err := r.row.Scan(dest...)
+	if err != nil && err != pgx.ErrNoRows {
+		tracing.Fail(r.span, err, "sql error")
+	}
+	return err
+}
+
+// startSpan starts the span of the statement named by its operation, e.g. SELECT
+func startSpan(ctx context.Context, sql string) (context.Context, trace.Span) {
+	var operation string
+	if fields := strings.Fields(sql); len(fields) > 0 {
+		operation = strings.ToUpper(fields[0])
+	}
+	return tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationKey.String(operation),
+			semconv.DBStatementKey.String(sql),
+		),
+	)
+}
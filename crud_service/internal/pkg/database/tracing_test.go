@@ -0,0 +1,153 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+)
+
+// recorder keeps spans of all tests, since the tracer of the package is bound to the first provider
+var recorder = tracetest.NewSpanRecorder()
+
+func TestMain(m *testing.M) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	os.Exit(m.Run())
+}
+
+// rowMock returns the error of scanning
+type rowMock struct {
+	err error
+}
+
+func (r rowMock) Scan(...interface{}) error {
+	return r.err
+}
+
+// endedSpans returns spans ended by the call of f
+func endedSpans(f func()) []sdktrace.ReadOnlySpan {
+	before := len(recorder.Ended())
+	f()
+	return recorder.Ended()[before:]
+}
+
+func TestTrace(t *testing.T) {
+	t.Run("query span ends on close of rows", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		query := `SELECT id FROM users`
+		rows := pgxpoolmock.NewRows([]string{"id"}).AddRow(uint(1)).ToPgxRows()
+		mockPool.EXPECT().Query(gomock.Any(), query).Return(rows, nil)
+
+		// act
+		var result pgx.Rows
+		spans := endedSpans(func() {
+			var err error
+			result, err = Trace(mockPool).Query(context.Background(), query)
+			require.NoError(t, err)
+		})
+		require.Empty(t, spans)
+		spans = endedSpans(result.Close)
+
+		// assert
+		require.Len(t, spans, 1)
+		assert.Equal(t, "SELECT", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), semconv.DBSystemPostgreSQL)
+		assert.Contains(t, spans[0].Attributes(), semconv.DBStatementKey.String(query))
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	})
+
+	t.Run("exec error", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		query := `DELETE FROM users WHERE id = $1`
+		mockPool.EXPECT().Exec(gomock.Any(), query, uint(1)).Return(pgconn.CommandTag{}, errors.New("connection lost"))
+
+		// act
+		spans := endedSpans(func() {
+			_, err := Trace(mockPool).Exec(context.Background(), query, uint(1))
+			require.Error(t, err)
+		})
+
+		// assert
+		require.Len(t, spans, 1)
+		assert.Equal(t, "DELETE", spans[0].Name())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		require.Len(t, spans[0].Events(), 1)
+	})
+
+	t.Run("no rows is not an error", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		query := `SELECT id FROM users WHERE id = $1`
+		mockPool.EXPECT().QueryRow(gomock.Any(), query, uint(1)).Return(rowMock{err: pgx.ErrNoRows})
+
+		// act
+		spans := endedSpans(func() {
+			var id uint
+			err := Trace(mockPool).QueryRow(context.Background(), query, uint(1)).Scan(&id)
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+		})
+
+		// assert
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	})
+
+	t.Run("queries of transaction are children of its span", func(t *testing.T) {
+		// arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+		mockTx := pgxpoolmock.NewMockPgxPool(ctrl)
+		query := `UPDATE users SET name = $1`
+		mockPool.EXPECT().BeginTxFunc(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ pgx.TxOptions, f func(pgx.Tx) error) error {
+				return f(&txMock{pool: mockTx})
+			})
+		mockTx.EXPECT().Exec(gomock.Any(), query, "admin").Return(pgconn.CommandTag("UPDATE 1"), nil)
+
+		// act
+		spans := endedSpans(func() {
+			err := Trace(mockPool).BeginFunc(context.Background(), func(tx pgx.Tx) error {
+				_, err := tx.Exec(context.Background(), query, "admin")
+				return err
+			})
+			require.NoError(t, err)
+		})
+
+		// assert
+		require.Len(t, spans, 2)
+		assert.Equal(t, "UPDATE", spans[0].Name())
+		assert.Equal(t, "BEGIN", spans[1].Name())
+		assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	})
+}
+
+// txMock passes queries of the transaction to the mocked pool, so they are expected in the same way
+type txMock struct {
+	pgx.Tx
+	pool *pgxpoolmock.MockPgxPool
+}
+
+func (tx *txMock) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	return tx.pool.Exec(ctx, sql, arguments...)
+}
@@ -0,0 +1,110 @@
+// This package converts cursors of keyset pagination to opaque page tokens and back
+package pagetoken
+
+import (
+	"crypto/aes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Cipher encrypts and signs tokens. It is implemented by aescbc package of golib, the backend sets it by the config.
+// The encrypted token starts with the iv, so the token is decrypted by the cipher made with any iv.
+type Cipher interface {
+	SecuredEncryptBase64(data string, isSafeMode bool) (string, error)
+	SecuredDecryptBase64(data string, isSafeMode bool) (string, error)
+}
+
+// CipherFunc returns the cipher encrypting by the base64 iv. Every token is encrypted with a new random iv,
+// so tokens of the same cursor differ and do not reveal that the cursors are equal.
+type CipherFunc func(iv string) Cipher
+
+// newCipher is not set by default, so tokens are just encoded
+var newCipher CipherFunc
+
+func SetCipher(f CipherFunc) {
+	newCipher = f
+}
+
+type token struct {
+	Field      string `json:"f"`
+	Descending bool   `json:"d,omitempty"`
+	Value      string `json:"v,omitempty"`
+	Id         uint   `json:"i"`
+}
+
+func Encode(cursor models.Cursor) (string, error) {
+	data, err := json.Marshal(token{
+		Field:      cursor.Field,
+		Descending: cursor.Descending,
+		Value:      cursor.Value,
+		Id:         cursor.Id,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "encode page token")
+	}
+
+	if newCipher == nil {
+		return base64.RawURLEncoding.EncodeToString(data), nil
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return "", errors.Wrap(err, "generate iv of page token")
+	}
+	encrypted, err := newCipher(base64.StdEncoding.EncodeToString(iv)).SecuredEncryptBase64(string(data), true)
+	if err != nil {
+		return "", errors.Wrap(err, "encrypt page token")
+	}
+	// standard base64 of the cipher is not safe to be passed in URL
+	return base64.RawURLEncoding.EncodeToString([]byte(encrypted)), nil
+}
+
+func Decode(pageToken string) (*models.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if newCipher != nil {
+		decrypted, err := decrypt(string(data))
+		if err != nil {
+			return nil, ErrInvalidPageToken
+		}
+		data = []byte(decrypted)
+	}
+
+	var t token
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if t.Id == 0 || models.GetSortingFieldName(t.Field) == "" {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &models.Cursor{
+		SortingOrder: models.SortingOrder{
+			Field:      t.Field,
+			Descending: t.Descending,
+		},
+		Value: t.Value,
+		Id:    t.Id,
+	}, nil
+}
+
+// decrypt protects the service from panic of the cipher on malformed input
+func decrypt(data string) (result string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("decrypt page token: %v", r)
+		}
+	}()
+	// the iv is read from the token
+	return newCipher("").SecuredDecryptBase64(data, true)
+}
@@ -0,0 +1,161 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vldem/go-code-example/golib/pkg/crypt/aescbc"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+type cipherStub struct{}
+
+func newCipherStub(string) Cipher {
+	return cipherStub{}
+}
+
+// newAesCbc returns the cipher of golib configured as in the backend
+func newAesCbc(iv string) Cipher {
+	return aescbc.New(config.PageTokenConfig.EncryptionKey, config.PageTokenConfig.SignKey, iv)
+}
+
+func (cipherStub) SecuredEncryptBase64(data string, _ bool) (string, error) {
+	return "sig:" + data, nil
+}
+
+func (cipherStub) SecuredDecryptBase64(data string, _ bool) (string, error) {
+	if len(data) < 4 || data[:4] != "sig:" {
+		panic("index out of range")
+	}
+	return data[4:], nil
+}
+
+func TestPageToken(t *testing.T) {
+	cursor := models.Cursor{
+		SortingOrder: models.SortingOrder{Field: "email", Descending: true},
+		Value:        "test01@dummy.com",
+		Id:           7,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Run("plain", func(t *testing.T) {
+			// act
+			token, err := Encode(cursor)
+			require.NoError(t, err)
+			result, err := Decode(token)
+
+			// assert
+			require.NoError(t, err)
+			assert.Equal(t, &cursor, result)
+		})
+
+		t.Run("with cipher", func(t *testing.T) {
+			// arrange
+			SetCipher(newCipherStub)
+			defer SetCipher(nil)
+
+			// act
+			token, err := Encode(cursor)
+			require.NoError(t, err)
+			result, err := Decode(token)
+
+			// assert
+			require.NoError(t, err)
+			assert.Equal(t, &cursor, result)
+		})
+
+		t.Run("with aescbc", func(t *testing.T) {
+			// arrange
+			SetCipher(newAesCbc)
+			defer SetCipher(nil)
+
+			// act
+			token, err := Encode(cursor)
+			require.NoError(t, err)
+			result, err := Decode(token)
+
+			// assert
+			require.NoError(t, err)
+			assert.Equal(t, &cursor, result)
+			assert.NotContains(t, token, "=")
+		})
+
+		t.Run("tokens of the same cursor differ", func(t *testing.T) {
+			// arrange
+			SetCipher(newAesCbc)
+			defer SetCipher(nil)
+
+			// act
+			first, err := Encode(cursor)
+			require.NoError(t, err)
+			second, err := Encode(cursor)
+			require.NoError(t, err)
+
+			// assert
+			assert.NotEqual(t, first, second)
+			for _, token := range []string{first, second} {
+				result, err := Decode(token)
+				require.NoError(t, err)
+				assert.Equal(t, &cursor, result)
+			}
+		})
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("malformed token", func(t *testing.T) {
+			// act
+			_, err := Decode("not a token")
+
+			// assert
+			require.ErrorIs(t, err, ErrInvalidPageToken)
+		})
+
+		t.Run("unknown sorting field", func(t *testing.T) {
+			// arrange
+			token, err := Encode(models.Cursor{SortingOrder: models.SortingOrder{Field: "password"}, Id: 1})
+			require.NoError(t, err)
+
+			// act
+			_, err = Decode(token)
+
+			// assert
+			require.ErrorIs(t, err, ErrInvalidPageToken)
+		})
+
+		t.Run("tampered token", func(t *testing.T) {
+			// arrange
+			SetCipher(newAesCbc)
+			defer SetCipher(nil)
+			token, err := Encode(cursor)
+			require.NoError(t, err)
+			data, err := base64.RawURLEncoding.DecodeString(token)
+			require.NoError(t, err)
+			encrypted, err := base64.StdEncoding.DecodeString(string(data))
+			require.NoError(t, err)
+			encrypted[len(encrypted)-1] ^= 1
+
+			// act
+			_, err = Decode(base64.RawURLEncoding.EncodeToString([]byte(base64.StdEncoding.EncodeToString(encrypted))))
+
+			// assert
+			require.ErrorIs(t, err, ErrInvalidPageToken)
+		})
+
+		t.Run("token is not signed", func(t *testing.T) {
+			// arrange
+			token, err := Encode(cursor)
+			require.NoError(t, err)
+			SetCipher(newCipherStub)
+			defer SetCipher(nil)
+
+			// act
+			_, err = Decode(token)
+
+			// assert
+			require.ErrorIs(t, err, ErrInvalidPageToken)
+		})
+	})
+}
@@ -0,0 +1,241 @@
+package queue
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var tracer = otel.Tracer("gitlab.ozon.dev/vldem/homework1/internal/pkg/queue")
+
+// Broker of the client publishes requests and reads replies from the partition
+type Broker interface {
+	broker.Publisher
+	broker.PartitionReader
+}
+
+// Client sends requests over the broker and waits for replies to them. Every instance reads replies
+// from its own partition of the reply topic without consumer group, so that instances do not take
+// replies of each other. Replies are matched to requests by correlation id.
+type Client struct {
+	broker     Broker
+	topic      string
+	replyTopic string
+	partition  int32
+	timeout    time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*pendingRequest
+
+	stop context.CancelFunc
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+type pendingRequest struct {
+	deadline time.Time
+	reply    chan *broker.Message
+}
+
+// NewClient returns the client sending requests to topic and reading replies from the random partition
+// of replyTopic, timeout is used for requests whose context has no deadline
+func NewClient(b Broker, topic, replyTopic string, timeout time.Duration) (*Client, error) {
+	partitions, err := b.Partitions(replyTopic)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading partitions of %v topic", replyTopic)
+	}
+	if len(partitions) == 0 {
+		return nil, errors.Errorf("%v topic has no partitions", replyTopic)
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(partitions))))
+	if err != nil {
+		return nil, err
+	}
+	partition := partitions[n.Int64()]
+
+	// only replies sent after the start are read, so the reader is started before requests are sent
+	ctx, stop := context.WithCancel(context.Background())
+	replies, err := b.ReadPartition(ctx, replyTopic, partition)
+	if err != nil {
+		stop()
+		return nil, err
+	}
+
+	c := &Client{
+		broker:     b,
+		topic:      topic,
+		replyTopic: replyTopic,
+		partition:  partition,
+		timeout:    timeout,
+		pending:    make(map[string]*pendingRequest),
+		stop:       stop,
+		done:       make(chan struct{}),
+	}
+	c.wg.Add(1)
+	go c.run(replies)
+	return c, nil
+}
+
+// Do sends the command and reads data of the reply into v. The error of the reply is returned
+// as the status of the reply code, codes.DeadlineExceeded is returned if there is no reply in time.
+// Access token added to ctx by auth.ContextWithToken is sent in the HeaderAccessToken header.
+func (c *Client) Do(ctx context.Context, command string, data proto.Message, v proto.Message) error {
+	ctx, span := tracer.Start(ctx, "queue/"+command,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingDestinationKindTopic,
+			semconv.MessagingDestinationKey.String(c.topic),
+		),
+	)
+	defer span.End()
+
+	if err := c.do(ctx, span, command, data, v); err != nil {
+		tracing.Fail(span, err, "request error")
+		return err
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, span trace.Span, command string, data proto.Message, v proto.Message) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	id, err := correlationId()
+	if err != nil {
+		return err
+	}
+	value, err := NewRequest(command, id, data)
+	if err != nil {
+		return errors.Wrap(err, "marshaling request")
+	}
+
+	span.SetAttributes(semconv.MessagingConversationIDKey.String(id))
+
+	request := c.register(ctx, id)
+	defer c.unregister(id)
+
+	headers := map[string]string{
+		HeaderContentType:    ContentTypeProtobuf,
+		HeaderCorrelationId:  id,
+		HeaderReplyTo:        c.replyTopic,
+		HeaderReplyPartition: strconv.FormatInt(int64(c.partition), 10),
+	}
+	if token, ok := auth.TokenFromContext(ctx); ok {
+		headers[HeaderAccessToken] = token
+	}
+
+	err = c.broker.Publish(ctx, &broker.Message{
+		Topic:     c.topic,
+		Partition: broker.AnyPartition,
+		Key:       []byte(id),
+		Value:     value,
+		Headers:   headers,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "sending request to %v topic", c.topic)
+	}
+
+	select {
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return status.Errorf(codes.DeadlineExceeded, "no reply to %v request [%v]", command, id)
+		}
+		return status.FromContextError(ctx.Err()).Err()
+	case <-c.done:
+		return status.Error(codes.Unavailable, "client is closed")
+	case msg := <-request.reply:
+		response, err := DecodeResponse(msg)
+		if err != nil {
+			return errors.Wrapf(err, "unmarshaling reply to request [%v]", id)
+		}
+		if err := response.Err(); err != nil {
+			return err
+		}
+		if v == nil {
+			return nil
+		}
+		if err := response.Decode(v); err != nil {
+			return errors.Wrapf(err, "unmarshaling data of reply to request [%v]", id)
+		}
+		return nil
+	}
+}
+
+// Close stops reading replies, requests waiting for them get codes.Unavailable
+func (c *Client) Close() error {
+	close(c.done)
+	c.stop()
+	c.wg.Wait()
+	return nil
+}
+
+func (c *Client) register(ctx context.Context, id string) *pendingRequest {
+	deadline, _ := ctx.Deadline()
+	request := &pendingRequest{
+		deadline: deadline,
+		reply:    make(chan *broker.Message, 1),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending[id] = request
+	return request
+}
+
+func (c *Client) unregister(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.pending, id)
+}
+
+// run passes replies to requests waiting for them. The partition may be shared with other instances,
+// so replies to unknown requests are skipped, as well as late replies to expired requests.
+func (c *Client) run(replies <-chan *broker.Message) {
+	defer c.wg.Done()
+	for msg := range replies {
+		id := msg.Headers[HeaderCorrelationId]
+		c.mu.Lock()
+		request, ok := c.pending[id]
+		if ok {
+			delete(c.pending, id)
+		}
+		c.mu.Unlock()
+
+		if !ok {
+			continue
+		}
+		if !request.deadline.IsZero() && time.Now().After(request.deadline) {
+			loggerPkg.Logger.Log.Info("late reply is skipped", zap.String("correlation id", id))
+			continue
+		}
+		request.reply <- msg
+	}
+}
+
+func correlationId() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generating correlation id")
+	}
+	return hex.EncodeToString(b), nil
+}
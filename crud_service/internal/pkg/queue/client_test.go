@@ -0,0 +1,189 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// responder replies to published requests as services do
+type responder struct {
+	*broker.Memory
+	reply func(request *broker.Message) (proto.Message, error)
+
+	requests []*broker.Message
+}
+
+func (r *responder) Publish(ctx context.Context, msg *broker.Message) error {
+	if err := r.Memory.Publish(ctx, msg); err != nil {
+		return err
+	}
+	if msg.Topic != "requests" || r.reply == nil {
+		return nil
+	}
+	r.requests = append(r.requests, msg)
+
+	request, err := DecodeRequest(msg)
+	if err != nil {
+		return err
+	}
+	data, err := r.reply(msg)
+	value, err := NewResponse(request, data, err)
+	if err != nil {
+		return err
+	}
+	// reply to another request sharing the partition is skipped
+	other := Reply(msg, "responses", value)
+	other.Headers[HeaderCorrelationId] = "other"
+	if err := r.Memory.Publish(ctx, other); err != nil {
+		return err
+	}
+	return r.Memory.Publish(ctx, Reply(msg, "responses", value))
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		b := &responder{Memory: broker.NewMemory(4), reply: func(*broker.Message) (proto.Message, error) {
+			return &pb.BackendUserGetResponse{Id: 1, Name: "Test Tester"}, nil
+		}}
+		client, err := NewClient(b, "requests", "replies", time.Second)
+		require.NoError(t, err)
+		defer client.Close()
+
+		// act
+		result := &pb.BackendUserGetResponse{}
+		err = client.Do(ctx, CommandUserGet, &pb.UserGetRequest{Id: 1}, result)
+
+		// assert
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(&pb.BackendUserGetResponse{Id: 1, Name: "Test Tester"}, result))
+		require.Len(t, b.requests, 1)
+		h := b.requests[0].Headers
+		assert.NotEmpty(t, h[HeaderCorrelationId])
+		assert.Equal(t, "replies", h[HeaderReplyTo])
+		assert.Equal(t, ContentTypeProtobuf, h[HeaderContentType])
+		assert.NotContains(t, h, HeaderAccessToken)
+		// replies are published to the partition read by the client
+		replies := b.Messages("replies")
+		require.Len(t, replies, 2)
+		assert.Equal(t, h[HeaderReplyPartition], replies[1].Headers[HeaderReplyPartition])
+		assert.Equal(t, client.partition, replies[1].Partition)
+	})
+
+	t.Run("access token", func(t *testing.T) {
+		// arrange
+		b := &responder{Memory: broker.NewMemory(4), reply: func(*broker.Message) (proto.Message, error) {
+			return &pb.BackendUserGetResponse{Id: 1}, nil
+		}}
+		client, err := NewClient(b, "requests", "replies", time.Second)
+		require.NoError(t, err)
+		defer client.Close()
+
+		// act
+		err = client.Do(auth.ContextWithToken(ctx, "token"), CommandUserGet, &pb.UserGetRequest{Id: 1}, &pb.BackendUserGetResponse{})
+
+		// assert
+		require.NoError(t, err)
+		require.Len(t, b.requests, 1)
+		assert.Equal(t, "token", b.requests[0].Headers[HeaderAccessToken])
+		// the token is not sent back in the reply
+		replies := b.Messages("replies")
+		require.Len(t, replies, 2)
+		assert.NotContains(t, replies[1].Headers, HeaderAccessToken)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("from reply", func(t *testing.T) {
+			// arrange
+			b := &responder{Memory: broker.NewMemory(4), reply: func(*broker.Message) (proto.Message, error) {
+				return nil, status.Error(codes.NotFound, "user does not exist")
+			}}
+			client, err := NewClient(b, "requests", "replies", time.Second)
+			require.NoError(t, err)
+			defer client.Close()
+
+			// act
+			err = client.Do(ctx, CommandUserGet, &pb.UserGetRequest{Id: 1}, nil)
+
+			// assert
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
+		t.Run("deadline exceeded", func(t *testing.T) {
+			// arrange
+			client, err := NewClient(broker.NewMemory(4), "requests", "replies", time.Millisecond)
+			require.NoError(t, err)
+			defer client.Close()
+
+			// act
+			err = client.Do(ctx, CommandUserGet, &pb.UserGetRequest{Id: 1}, nil)
+
+			// assert
+			assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+			assert.Empty(t, client.pending)
+		})
+	})
+}
+
+func TestReply(t *testing.T) {
+	request := &broker.Message{
+		Key:   []byte("key"),
+		Value: []byte("request"),
+		Headers: map[string]string{
+			HeaderCorrelationId:  "1",
+			HeaderReplyTo:        "replies",
+			HeaderReplyPartition: "2",
+			"request-id":         "abc",
+		},
+	}
+
+	t.Run("reply", func(t *testing.T) {
+		// act
+		msg := Reply(request, "responses", []byte("response"))
+
+		// assert
+		assert.Equal(t, "replies", msg.Topic)
+		assert.Equal(t, int32(2), msg.Partition)
+		assert.Equal(t, map[string]string{
+			HeaderContentType:    ContentTypeProtobuf,
+			HeaderCorrelationId:  "1",
+			HeaderReplyTo:        "replies",
+			HeaderReplyPartition: "2",
+		}, msg.Headers)
+	})
+
+	t.Run("forward", func(t *testing.T) {
+		// act
+		msg := Forward(request, "requests", []byte("request"))
+
+		// assert
+		assert.Equal(t, "requests", msg.Topic)
+		// forwarded requests are partitioned by key
+		assert.Equal(t, broker.AnyPartition, msg.Partition)
+		assert.Equal(t, "abc", msg.Headers["request-id"])
+		assert.Equal(t, "1", msg.Headers[HeaderCorrelationId])
+		assert.Equal(t, ContentTypeProtobuf, msg.Headers[HeaderContentType])
+	})
+
+	t.Run("without reply headers", func(t *testing.T) {
+		// act
+		msg := Reply(&broker.Message{Key: []byte("key")}, "responses", []byte("response"))
+
+		// assert
+		assert.Equal(t, "responses", msg.Topic)
+		assert.Equal(t, broker.AnyPartition, msg.Partition)
+		assert.Equal(t, map[string]string{HeaderContentType: ContentTypeProtobuf}, msg.Headers)
+	})
+}
@@ -0,0 +1,148 @@
+package queue
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/tracing"
+)
+
+// ConsumeClaim processes messages of the claim until it is released.
+// Messages are processed by the pool of workers, messages with the same key are processed by the same
+// worker, so they keep their order. The message is acked only after it and all the
+// messages before it in the partition are processed, so that nothing is lost if the consumer stops.
+// Processing stops on the first error of handle, unacked messages are consumed again after the claim is assigned again.
+func ConsumeClaim(claim broker.Claim, workers int, handle HandlerFunc) error {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(claim.Context())
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		errOnce sync.Once
+		failure error
+	)
+	fail := func(err error) {
+		errOnce.Do(func() {
+			failure = err
+			cancel()
+		})
+	}
+
+	offsets := &offsetTracker{claim: claim}
+	queues := make([]chan *broker.Message, workers)
+	for n := range queues {
+		queues[n] = make(chan *broker.Message)
+		wg.Add(1)
+		go func(queue <-chan *broker.Message) {
+			defer wg.Done()
+			for msg := range queue {
+				if err := process(ctx, msg, handle); err != nil {
+					// errors caused by the shutdown are not failures of processing
+					if ctx.Err() == nil {
+						fail(err)
+					}
+					continue
+				}
+				offsets.done(msg)
+			}
+		}(queues[n])
+	}
+
+	dispatch(ctx, claim.Messages(), queues, offsets)
+
+	// in-flight messages are finished, so that they are acked before the claim is released
+	for _, queue := range queues {
+		close(queue)
+	}
+	wg.Wait()
+	return failure
+}
+
+// process handles the message in the span continuing the trace of the message publisher
+func process(ctx context.Context, msg *broker.Message, handle HandlerFunc) error {
+	ctx, span := broker.StartSpan(ctx, "queue/consume "+msg.Topic, msg)
+	defer span.End()
+
+	if err := handle(ctx, msg); err != nil {
+		tracing.Fail(span, err, "processing error")
+		return err
+	}
+	return nil
+}
+
+// dispatch passes messages to workers until the channel is closed or the context is done
+func dispatch(ctx context.Context, messages <-chan *broker.Message, queues []chan *broker.Message, offsets *offsetTracker) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			offsets.add(msg)
+			select {
+			case <-ctx.Done():
+				return
+			case queues[worker(msg, len(queues))] <- msg:
+			}
+		}
+	}
+}
+
+// worker returns the index of the worker processing the message, messages without key
+// have no order, so they are spread over workers by offset
+func worker(msg *broker.Message, workers int) int {
+	if len(msg.Key) == 0 {
+		return int(msg.Offset % int64(workers))
+	}
+	h := fnv.New32a()
+	h.Write(msg.Key)
+	return int(h.Sum32() % uint32(workers))
+}
+
+// offsetTracker acks the message when all messages before it are processed,
+// because the ack of the message commits all the previous ones
+type offsetTracker struct {
+	claim broker.Claim
+
+	mu        sync.Mutex
+	pending   []*broker.Message
+	processed map[int64]struct{}
+}
+
+// add registers the message in order of consuming
+func (t *offsetTracker) add(msg *broker.Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pending = append(t.pending, msg)
+}
+
+func (t *offsetTracker) done(msg *broker.Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.processed == nil {
+		t.processed = make(map[int64]struct{})
+	}
+	t.processed[msg.Offset] = struct{}{}
+
+	var last *broker.Message
+	for len(t.pending) > 0 {
+		if _, ok := t.processed[t.pending[0].Offset]; !ok {
+			break
+		}
+		last = t.pending[0]
+		delete(t.processed, last.Offset)
+		t.pending = t.pending[1:]
+	}
+	if last != nil {
+		t.claim.Ack(last)
+	}
+}
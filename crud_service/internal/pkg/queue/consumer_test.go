@@ -0,0 +1,154 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+)
+
+type claim struct {
+	broker.Claim
+	ctx      context.Context
+	messages chan *broker.Message
+
+	mu    sync.Mutex
+	acked []int64
+}
+
+func newClaim(ctx context.Context, keys ...string) *claim {
+	c := &claim{ctx: ctx, messages: make(chan *broker.Message, len(keys))}
+	for offset, key := range keys {
+		c.messages <- &broker.Message{Key: []byte(key), Offset: int64(offset)}
+	}
+	close(c.messages)
+	return c
+}
+
+func (c *claim) Context() context.Context {
+	return c.ctx
+}
+
+func (c *claim) Messages() <-chan *broker.Message {
+	return c.messages
+}
+
+func (c *claim) Ack(msg *broker.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.acked = append(c.acked, msg.Offset)
+}
+
+// last returns the offset of the last acked message, -1 if nothing is acked
+func (c *claim) last() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.acked) == 0 {
+		return -1
+	}
+	return c.acked[len(c.acked)-1]
+}
+
+func TestConsumeClaim(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		keys := make([]string, 100)
+		for n := range keys {
+			keys[n] = fmt.Sprintf("key%d", n%5)
+		}
+		c := newClaim(ctx, keys...)
+		var mu sync.Mutex
+		processed := make(map[string][]int64)
+
+		// act
+		err := ConsumeClaim(c, 4, func(_ context.Context, msg *broker.Message) error {
+			mu.Lock()
+			defer mu.Unlock()
+			processed[string(msg.Key)] = append(processed[string(msg.Key)], msg.Offset)
+			return nil
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(99), c.last())
+		assert.Len(t, processed, 5)
+		for key, offsets := range processed {
+			assert.Len(t, offsets, 20, key)
+			assert.IsIncreasing(t, offsets, key)
+		}
+	})
+
+	t.Run("acks after previous messages are processed", func(t *testing.T) {
+		// arrange
+		c := newClaim(ctx, "a", "b")
+		release := make(chan struct{})
+		second := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		// act
+		go func() {
+			defer wg.Done()
+			_ = ConsumeClaim(c, 2, func(_ context.Context, msg *broker.Message) error {
+				if msg.Offset == 0 {
+					<-release
+					return nil
+				}
+				close(second)
+				return nil
+			})
+		}()
+		<-second
+
+		// assert
+		assert.Equal(t, int64(-1), c.last())
+		close(release)
+		wg.Wait()
+		assert.Equal(t, int64(1), c.last())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		c := newClaim(ctx, "a", "a", "a")
+
+		// act
+		err := ConsumeClaim(c, 2, func(_ context.Context, msg *broker.Message) error {
+			if msg.Offset == 1 {
+				return errors.New("broker is unavailable")
+			}
+			return nil
+		})
+
+		// assert
+		assert.EqualError(t, err, "broker is unavailable")
+		assert.Equal(t, int64(0), c.last())
+	})
+
+	t.Run("stops when claim is released", func(t *testing.T) {
+		// arrange
+		ctx, cancel := context.WithCancel(ctx)
+		c := &claim{ctx: ctx, messages: make(chan *broker.Message)}
+		done := make(chan error)
+
+		// act
+		go func() {
+			done <- ConsumeClaim(c, 2, func(context.Context, *broker.Message) error {
+				return nil
+			})
+		}()
+		c.messages <- &broker.Message{Offset: 0}
+		cancel()
+
+		// assert
+		assert.NoError(t, <-done)
+	})
+}
@@ -0,0 +1,158 @@
+package queue
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Headers of dead-lettered messages describing the failure, headers of the original message are kept as well
+// except the access token, which must not be retained in the dead-letter topic
+const (
+	HeaderPrefix    = "dlq-"
+	HeaderTopic     = HeaderPrefix + "topic"
+	HeaderPartition = HeaderPrefix + "partition"
+	HeaderOffset    = HeaderPrefix + "offset"
+	HeaderGroup     = HeaderPrefix + "group"
+	HeaderError     = HeaderPrefix + "error"
+	HeaderAttempts  = HeaderPrefix + "attempts"
+	HeaderFailedAt  = HeaderPrefix + "failed-at"
+)
+
+// Permanent marks the error which cannot be fixed by retry, e.g. malformed message,
+// such messages are dead-lettered at once
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// HandlerFunc processes the consumed message
+type HandlerFunc func(ctx context.Context, msg *broker.Message) error
+
+// DeadLetter retries messages failed by transient errors and moves the ones which still fail
+// to the dead-letter topic, so that one bad message neither crashes the service nor blocks the partition
+type DeadLetter struct {
+	P     broker.Publisher
+	Group string
+	Retry config.RetryCfg
+}
+
+// Process handles the message, nil is returned if the message is processed or dead-lettered,
+// so that it can be marked as consumed. Otherwise the message must be consumed again.
+func (d *DeadLetter) Process(ctx context.Context, msg *broker.Message, handle HandlerFunc) error {
+	attempts, err := d.retry(ctx, msg, func() error {
+		return handle(ctx, msg)
+	})
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	loggerPkg.Logger.Log.Error("message is dead-lettered",
+		zap.String("topic", msg.Topic),
+		zap.Int64("offset", msg.Offset),
+		zap.Int("attempts", attempts),
+		zap.Error(err),
+	)
+	return d.send(ctx, msg, err, attempts)
+}
+
+// Publish sends the message produced by the handler, e.g. the reply to the request, retrying transient failures.
+// The handler publishes by it instead of failing, so that the command of the request is not run again by retries
+// when only the publishing fails. The message still failing is dead-lettered in place of the handled one.
+func (d *DeadLetter) Publish(ctx context.Context, msg *broker.Message) error {
+	attempts, err := d.retry(ctx, msg, func() error {
+		return d.P.Publish(ctx, msg)
+	})
+	if err == nil || ctx.Err() != nil {
+		return err
+	}
+
+	loggerPkg.Logger.Log.Error("published message is dead-lettered",
+		zap.String("topic", msg.Topic),
+		zap.Int("attempts", attempts),
+		zap.Error(err),
+	)
+	return d.send(ctx, msg, err, attempts)
+}
+
+// retry calls op until it succeeds, fails by a permanent error or the attempts are exhausted,
+// the number of attempts is returned with the last error
+func (d *DeadLetter) retry(ctx context.Context, msg *broker.Message, op func() error) (int, error) {
+	attempts := 0
+	backoff := d.Retry.Backoff
+	for {
+		attempts++
+		err := op()
+		if err == nil || IsPermanent(err) || attempts >= d.Retry.Attempts {
+			return attempts, err
+		}
+
+		loggerPkg.Logger.Log.Info("message processing failed, retrying",
+			zap.String("topic", msg.Topic),
+			zap.Int64("offset", msg.Offset),
+			zap.Int("attempt", attempts),
+			zap.Error(err),
+		)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempts, ctx.Err()
+		case <-timer.C:
+		}
+		if backoff *= 2; backoff > d.Retry.MaxBackoff {
+			backoff = d.Retry.MaxBackoff
+		}
+	}
+}
+
+func (d *DeadLetter) send(ctx context.Context, msg *broker.Message, cause error, attempts int) error {
+	headers := make(map[string]string, len(msg.Headers)+7)
+	for key, value := range msg.Headers {
+		// headers of the previous failure are replaced, if the replayed message fails again
+		if !strings.HasPrefix(key, HeaderPrefix) && key != HeaderAccessToken {
+			headers[key] = value
+		}
+	}
+	headers[HeaderTopic] = msg.Topic
+	headers[HeaderPartition] = strconv.FormatInt(int64(msg.Partition), 10)
+	headers[HeaderOffset] = strconv.FormatInt(msg.Offset, 10)
+	headers[HeaderGroup] = d.Group
+	headers[HeaderError] = cause.Error()
+	headers[HeaderAttempts] = strconv.Itoa(attempts)
+	headers[HeaderFailedAt] = time.Now().UTC().Format(time.RFC3339)
+
+	return d.P.Publish(ctx, &broker.Message{
+		Topic:     config.TopicDeadLetter,
+		Partition: broker.AnyPartition,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   headers,
+	})
+}
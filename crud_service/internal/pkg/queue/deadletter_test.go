@@ -0,0 +1,212 @@
+package queue
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	loggerPkg "gitlab.ozon.dev/vldem/homework1/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	loggerPkg.Logger.Log = zap.NewNop()
+	os.Exit(m.Run())
+}
+
+// flaky is the publisher of the broker which fails the first publications
+type flaky struct {
+	*broker.Memory
+	failures int
+}
+
+func (f *flaky) Publish(ctx context.Context, msg *broker.Message) error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("broker is unavailable")
+	}
+	return f.Memory.Publish(ctx, msg)
+}
+
+// unavailable is the publisher of the broker which is down
+type unavailable struct{}
+
+func (unavailable) Publish(context.Context, *broker.Message) error {
+	return errors.New("broker is unavailable")
+}
+
+func TestDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	retry := config.RetryCfg{Attempts: 3}
+	message := &broker.Message{
+		Topic:     config.TopicUIRequest,
+		Partition: 1,
+		Offset:    10,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+		Headers:   map[string]string{"request-id": "1"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		deadLetter := &DeadLetter{P: b, Group: "group", Retry: retry}
+		attempts := 0
+
+		// act
+		err := deadLetter.Process(ctx, message, func(context.Context, *broker.Message) error {
+			if attempts++; attempts < 2 {
+				return errors.New("broker is unavailable")
+			}
+			return nil
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+		assert.Empty(t, b.Messages(config.TopicDeadLetter))
+	})
+
+	t.Run("permanent error", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		deadLetter := &DeadLetter{P: b, Group: "group", Retry: retry}
+		attempts := 0
+
+		// act
+		err := deadLetter.Process(ctx, message, func(context.Context, *broker.Message) error {
+			attempts++
+			return Permanent(errors.New("unmarshaling request"))
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempts)
+		messages := b.Messages(config.TopicDeadLetter)
+		require.Len(t, messages, 1)
+		msg := messages[0]
+		assert.Equal(t, []byte("key"), msg.Key)
+		assert.Equal(t, []byte("value"), msg.Value)
+		h := msg.Headers
+		assert.Equal(t, "1", h["request-id"])
+		assert.Equal(t, config.TopicUIRequest, h[HeaderTopic])
+		assert.Equal(t, "1", h[HeaderPartition])
+		assert.Equal(t, "10", h[HeaderOffset])
+		assert.Equal(t, "group", h[HeaderGroup])
+		assert.Equal(t, "unmarshaling request", h[HeaderError])
+		assert.Equal(t, "1", h[HeaderAttempts])
+		assert.NotEmpty(t, h[HeaderFailedAt])
+	})
+
+	t.Run("access token is dropped", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		deadLetter := &DeadLetter{P: b, Group: "group", Retry: retry}
+		withToken := *message
+		withToken.Headers = map[string]string{"request-id": "1", HeaderAccessToken: "token"}
+
+		// act
+		err := deadLetter.Process(ctx, &withToken, func(context.Context, *broker.Message) error {
+			return Permanent(errors.New("unmarshaling request"))
+		})
+
+		// assert
+		require.NoError(t, err)
+		messages := b.Messages(config.TopicDeadLetter)
+		require.Len(t, messages, 1)
+		assert.Equal(t, "1", messages[0].Headers["request-id"])
+		assert.NotContains(t, messages[0].Headers, HeaderAccessToken)
+	})
+
+	t.Run("attempts are exhausted", func(t *testing.T) {
+		// arrange
+		b := broker.NewMemory(1)
+		deadLetter := &DeadLetter{P: b, Group: "group", Retry: retry}
+		attempts := 0
+
+		// act
+		err := deadLetter.Process(ctx, message, func(context.Context, *broker.Message) error {
+			attempts++
+			return errors.New("broker is unavailable")
+		})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		messages := b.Messages(config.TopicDeadLetter)
+		require.Len(t, messages, 1)
+		assert.Equal(t, "3", messages[0].Headers[HeaderAttempts])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		deadLetter := &DeadLetter{P: unavailable{}, Group: "group", Retry: retry}
+
+		// act
+		err := deadLetter.Process(ctx, message, func(context.Context, *broker.Message) error {
+			return Permanent(errors.New("unmarshaling request"))
+		})
+
+		// assert
+		assert.Error(t, err)
+	})
+}
+
+func TestDeadLetterPublish(t *testing.T) {
+	ctx := context.Background()
+	retry := config.RetryCfg{Attempts: 3}
+	message := &broker.Message{
+		Topic:     config.TopicUIResponse,
+		Partition: broker.AnyPartition,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		b := &flaky{Memory: broker.NewMemory(1), failures: 2}
+		deadLetter := &DeadLetter{P: b, Group: "group", Retry: retry}
+
+		// act
+		err := deadLetter.Publish(ctx, message)
+
+		// assert
+		require.NoError(t, err)
+		assert.Len(t, b.Messages(config.TopicUIResponse), 1)
+		assert.Empty(t, b.Messages(config.TopicDeadLetter))
+	})
+
+	t.Run("attempts are exhausted", func(t *testing.T) {
+		// arrange
+		b := &flaky{Memory: broker.NewMemory(1), failures: 3}
+		deadLetter := &DeadLetter{P: b, Group: "group", Retry: retry}
+
+		// act
+		err := deadLetter.Publish(ctx, message)
+
+		// assert
+		require.NoError(t, err)
+		assert.Empty(t, b.Messages(config.TopicUIResponse))
+		messages := b.Messages(config.TopicDeadLetter)
+		require.Len(t, messages, 1)
+		assert.Equal(t, []byte("value"), messages[0].Value)
+		assert.Equal(t, config.TopicUIResponse, messages[0].Headers[HeaderTopic])
+		assert.Equal(t, "3", messages[0].Headers[HeaderAttempts])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		deadLetter := &DeadLetter{P: unavailable{}, Group: "group", Retry: retry}
+
+		// act
+		err := deadLetter.Publish(ctx, message)
+
+		// assert
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,248 @@
+// This package defines messages of requests sent over Kafka and replies to them.
+// Messages are envelopes of api/queue.proto carrying request and response messages of the gRPC methods
+// of the same names. Messages without the content-type header are JSON of the previous format,
+// they are still decoded during migration.
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Commands of requests
+const (
+	CommandUserCreate = "UserCreate"
+	CommandUserGet    = "UserGet"
+	CommandUserList   = "UserList"
+	CommandUserUpdate = "UserUpdate"
+	CommandUserDelete = "UserDelete"
+	CommandUsersAdd   = "UsersAdd"
+)
+
+// Version of envelopes written by the package, envelopes of newer versions are rejected
+const Version = 1
+
+const (
+	HeaderContentType   = "content-type"
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+// payloadOneof is the name of the oneof of envelopes carrying the message of the method
+const payloadOneof = "payload"
+
+// Request is the decoded request, its data is read by Decode
+type Request struct {
+	Command       string
+	CorrelationId string
+
+	envelope *pb.QueueRequest
+	// data of JSON requests
+	data json.RawMessage
+}
+
+// jsonRequest is the previous format of requests
+type jsonRequest struct {
+	Command     string          `json:"command"`
+	RequestData json.RawMessage `json:"RequestData"`
+}
+
+// NewRequest returns the encoded request of the command with the given data
+func NewRequest(command, correlationId string, data proto.Message) ([]byte, error) {
+	envelope := &pb.QueueRequest{
+		Version:       Version,
+		Command:       command,
+		CorrelationId: correlationId,
+	}
+	if err := setPayload(envelope, data); err != nil {
+		return nil, errors.Wrapf(err, "request of command: [%s]", command)
+	}
+	return proto.Marshal(envelope)
+}
+
+// DecodeRequest reads the request of the message in the format given by its content-type header
+func DecodeRequest(msg *broker.Message) (Request, error) {
+	switch contentType := msg.Headers[HeaderContentType]; contentType {
+	case ContentTypeProtobuf:
+		envelope := &pb.QueueRequest{}
+		if err := proto.Unmarshal(msg.Value, envelope); err != nil {
+			return Request{}, err
+		}
+		if envelope.GetVersion() > Version {
+			return Request{}, errors.Errorf("unsupported version of request: [%d]", envelope.GetVersion())
+		}
+		return Request{
+			Command:       envelope.GetCommand(),
+			CorrelationId: envelope.GetCorrelationId(),
+			envelope:      envelope,
+		}, nil
+	case "", ContentTypeJSON:
+		var request jsonRequest
+		if err := json.Unmarshal(msg.Value, &request); err != nil {
+			return Request{}, err
+		}
+		return Request{
+			Command:       request.Command,
+			CorrelationId: msg.Headers[HeaderCorrelationId],
+			data:          request.RequestData,
+		}, nil
+	default:
+		return Request{}, errors.Errorf("unsupported content type: [%s]", contentType)
+	}
+}
+
+// Decode reads data of the request into v, data of another method is an invalid argument of the command
+func (r Request) Decode(v proto.Message) error {
+	var err error
+	if r.envelope != nil {
+		err = getPayload(r.envelope, v)
+	} else {
+		err = decodeJSON(r.data, v)
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("bad data of command [%s]: %s", r.Command, err.Error()))
+	}
+	return nil
+}
+
+// Response is the decoded reply carrying either data of the reply or the status of the failed request
+type Response struct {
+	Command       string
+	CorrelationId string
+	Code          codes.Code
+	Message       string
+
+	envelope *pb.QueueResponse
+	// data of JSON responses
+	data json.RawMessage
+}
+
+// jsonResponse is the previous format of responses
+type jsonResponse struct {
+	Command string          `json:"command"`
+	Code    codes.Code      `json:"code"`
+	Message string          `json:"message,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// NewResponse returns the encoded reply to the request, data is ignored if the request has failed
+func NewResponse(request Request, data proto.Message, err error) ([]byte, error) {
+	envelope := &pb.QueueResponse{
+		Version:       Version,
+		Command:       request.Command,
+		CorrelationId: request.CorrelationId,
+		Code:          int32(status.Code(err)),
+	}
+	if err != nil {
+		envelope.Message = status.Convert(err).Message()
+		return proto.Marshal(envelope)
+	}
+	if err := setPayload(envelope, data); err != nil {
+		return nil, errors.Wrapf(err, "response of command: [%s]", request.Command)
+	}
+	return proto.Marshal(envelope)
+}
+
+// DecodeResponse reads the response of the message in the format given by its content-type header
+func DecodeResponse(msg *broker.Message) (Response, error) {
+	switch contentType := msg.Headers[HeaderContentType]; contentType {
+	case ContentTypeProtobuf:
+		envelope := &pb.QueueResponse{}
+		if err := proto.Unmarshal(msg.Value, envelope); err != nil {
+			return Response{}, err
+		}
+		if envelope.GetVersion() > Version {
+			return Response{}, errors.Errorf("unsupported version of response: [%d]", envelope.GetVersion())
+		}
+		return Response{
+			Command:       envelope.GetCommand(),
+			CorrelationId: envelope.GetCorrelationId(),
+			Code:          codes.Code(envelope.GetCode()),
+			Message:       envelope.GetMessage(),
+			envelope:      envelope,
+		}, nil
+	case "", ContentTypeJSON:
+		var response jsonResponse
+		if err := json.Unmarshal(msg.Value, &response); err != nil {
+			return Response{}, err
+		}
+		return Response{
+			Command:       response.Command,
+			CorrelationId: msg.Headers[HeaderCorrelationId],
+			Code:          response.Code,
+			Message:       response.Message,
+			data:          response.Data,
+		}, nil
+	default:
+		return Response{}, errors.Errorf("unsupported content type: [%s]", contentType)
+	}
+}
+
+// Err returns the status of the failed request or nil
+func (r Response) Err() error {
+	if r.Code == codes.OK {
+		return nil
+	}
+	return status.Error(r.Code, r.Message)
+}
+
+// Decode reads data of the reply into v
+func (r Response) Decode(v proto.Message) error {
+	if r.envelope != nil {
+		return getPayload(r.envelope, v)
+	}
+	return decodeJSON(r.data, v)
+}
+
+// setPayload sets the field of the payload oneof of the envelope having the type of data
+func setPayload(envelope proto.Message, data proto.Message) error {
+	m := envelope.ProtoReflect()
+	fields := m.Descriptor().Oneofs().ByName(payloadOneof).Fields()
+	name := data.ProtoReflect().Descriptor().FullName()
+	for n := 0; n < fields.Len(); n++ {
+		if field := fields.Get(n); field.Message().FullName() == name {
+			m.Set(field, protoreflect.ValueOfMessage(data.ProtoReflect()))
+			return nil
+		}
+	}
+	return errors.Errorf("unsupported payload: [%s]", name)
+}
+
+// getPayload reads the payload of the envelope into v, it must be of the same type
+func getPayload(envelope proto.Message, v proto.Message) error {
+	m := envelope.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName(payloadOneof))
+	if field == nil {
+		return errors.New("payload is not set")
+	}
+	payload := m.Get(field).Message().Interface()
+	if payload.ProtoReflect().Descriptor().FullName() != v.ProtoReflect().Descriptor().FullName() {
+		return errors.Errorf("unexpected payload: [%s]", payload.ProtoReflect().Descriptor().FullName())
+	}
+	proto.Merge(v, payload)
+	return nil
+}
+
+// decodeJSON reads data of the previous format, batches of UsersAdd were sent as arrays of users
+func decodeJSON(data json.RawMessage, v proto.Message) error {
+	switch batch := v.(type) {
+	case *pb.QueueRequest_UsersAddBatch:
+		if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+			return json.Unmarshal(data, &batch.Users)
+		}
+	case *pb.QueueResponse_UsersAddResults:
+		if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+			return json.Unmarshal(data, &batch.Users)
+		}
+	}
+	return json.Unmarshal(data, v)
+}
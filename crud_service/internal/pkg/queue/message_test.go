@@ -0,0 +1,163 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func protobufMessage(value []byte) *broker.Message {
+	return &broker.Message{
+		Value:   value,
+		Headers: map[string]string{HeaderContentType: ContentTypeProtobuf},
+	}
+}
+
+func TestRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		value, err := NewRequest(CommandUserGet, "1", &pb.BackendUserGetRequest{Id: 10, IncludeDeleted: true})
+		require.NoError(t, err)
+
+		// act
+		request, err := DecodeRequest(protobufMessage(value))
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, CommandUserGet, request.Command)
+		assert.Equal(t, "1", request.CorrelationId)
+		in := &pb.BackendUserGetRequest{}
+		require.NoError(t, request.Decode(in))
+		assert.True(t, proto.Equal(&pb.BackendUserGetRequest{Id: 10, IncludeDeleted: true}, in))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		// arrange
+		msg := &broker.Message{
+			Value:   []byte(`{"command":"UsersAdd","RequestData":[{"email":"test01@dummy.com","atomic":true}]}`),
+			Headers: map[string]string{HeaderCorrelationId: "1"},
+		}
+
+		// act
+		request, err := DecodeRequest(msg)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, CommandUsersAdd, request.Command)
+		assert.Equal(t, "1", request.CorrelationId)
+		in := &pb.QueueRequest_UsersAddBatch{}
+		require.NoError(t, request.Decode(in))
+		require.Len(t, in.GetUsers(), 1)
+		assert.Equal(t, "test01@dummy.com", in.GetUsers()[0].GetEmail())
+		assert.True(t, in.GetUsers()[0].GetAtomic())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Run("unsupported version", func(t *testing.T) {
+			// arrange
+			value, err := proto.Marshal(&pb.QueueRequest{Version: Version + 1, Command: CommandUserGet})
+			require.NoError(t, err)
+
+			// act
+			_, err = DecodeRequest(protobufMessage(value))
+
+			// assert
+			assert.EqualError(t, err, "unsupported version of request: [2]")
+		})
+
+		t.Run("unsupported content type", func(t *testing.T) {
+			// arrange
+			msg := &broker.Message{
+				Value:   []byte("<request/>"),
+				Headers: map[string]string{HeaderContentType: "application/xml"},
+			}
+
+			// act
+			_, err := DecodeRequest(msg)
+
+			// assert
+			assert.EqualError(t, err, "unsupported content type: [application/xml]")
+		})
+
+		t.Run("data of another method", func(t *testing.T) {
+			// arrange
+			value, err := NewRequest(CommandUserGet, "1", &pb.UserGetRequest{Id: 10})
+			require.NoError(t, err)
+			request, err := DecodeRequest(protobufMessage(value))
+			require.NoError(t, err)
+
+			// act
+			err = request.Decode(&pb.BackendUserGetRequest{})
+
+			// assert
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+
+		t.Run("unsupported payload", func(t *testing.T) {
+			// act
+			_, err := NewRequest(CommandUserGet, "1", &pb.UserEvent{})
+
+			// assert
+			assert.Error(t, err)
+		})
+	})
+}
+
+func TestResponse(t *testing.T) {
+	request := Request{Command: CommandUsersAdd, CorrelationId: "1"}
+
+	t.Run("success", func(t *testing.T) {
+		// arrange
+		value, err := NewResponse(request, &pb.QueueResponse_UsersAddResults{
+			Users: []*pb.BackendUsersAddResponse{{Id: 1}, {Code: int32(codes.InvalidArgument), Message: "bad email"}},
+		}, nil)
+		require.NoError(t, err)
+
+		// act
+		response, err := DecodeResponse(protobufMessage(value))
+
+		// assert
+		require.NoError(t, err)
+		require.NoError(t, response.Err())
+		assert.Equal(t, "1", response.CorrelationId)
+		out := &pb.QueueResponse_UsersAddResults{}
+		require.NoError(t, response.Decode(out))
+		require.Len(t, out.GetUsers(), 2)
+		assert.Equal(t, uint64(1), out.GetUsers()[0].GetId())
+		assert.Equal(t, "bad email", out.GetUsers()[1].GetMessage())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		// arrange
+		msg := &broker.Message{Value: []byte(`{"command":"UserGet","code":0,"data":{"id":1,"name":"Test Tester"}}`)}
+
+		// act
+		response, err := DecodeResponse(msg)
+
+		// assert
+		require.NoError(t, err)
+		out := &pb.BackendUserGetResponse{}
+		require.NoError(t, response.Decode(out))
+		assert.True(t, proto.Equal(&pb.BackendUserGetResponse{Id: 1, Name: "Test Tester"}, out))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// arrange
+		value, err := NewResponse(request, nil, status.Error(codes.NotFound, "user does not exist"))
+		require.NoError(t, err)
+
+		// act
+		response, err := DecodeResponse(protobufMessage(value))
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(response.Err()))
+		assert.EqualError(t, response.Err(), "rpc error: code = NotFound desc = user does not exist")
+	})
+}
@@ -0,0 +1,63 @@
+package queue
+
+import (
+	"strconv"
+
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/broker"
+)
+
+// Headers of requests routing replies to the client which sent the request, they are passed
+// through all services handling the request
+const (
+	HeaderCorrelationId  = "correlation-id"
+	HeaderReplyTo        = "reply-to"
+	HeaderReplyPartition = "reply-partition"
+)
+
+// HeaderAccessToken carries the access token of the client, it is passed through all services
+// handling the request like routing headers and checked by the service executing the command
+const HeaderAccessToken = "access-token"
+
+// Forward returns the message passing the request to the next service, headers of the request are kept.
+// The value is encoded by NewRequest, so the content type of the request is replaced.
+func Forward(request *broker.Message, topic string, value []byte) *broker.Message {
+	headers := make(map[string]string, len(request.Headers)+1)
+	for key, value := range request.Headers {
+		headers[key] = value
+	}
+	headers[HeaderContentType] = ContentTypeProtobuf
+	return &broker.Message{
+		Topic:     topic,
+		Partition: broker.AnyPartition,
+		Key:       request.Key,
+		Value:     value,
+		Headers:   headers,
+	}
+}
+
+// Reply returns the message replying to the request by the value encoded by NewResponse, it is sent
+// to the topic and partition requested by the client. Requests without reply headers are replied
+// to the topic, partitioned by key.
+func Reply(request *broker.Message, topic string, value []byte) *broker.Message {
+	msg := &broker.Message{
+		Topic:     topic,
+		Partition: broker.AnyPartition,
+		Key:       request.Key,
+		Value:     value,
+		Headers:   map[string]string{HeaderContentType: ContentTypeProtobuf},
+	}
+	if id, ok := request.Headers[HeaderCorrelationId]; ok {
+		msg.Headers[HeaderCorrelationId] = id
+	}
+	replyTo, ok := request.Headers[HeaderReplyTo]
+	if !ok {
+		return msg
+	}
+	msg.Topic = replyTo
+	msg.Headers[HeaderReplyTo] = replyTo
+	if partition, err := strconv.ParseInt(request.Headers[HeaderReplyPartition], 10, 32); err == nil {
+		msg.Partition = int32(partition)
+		msg.Headers[HeaderReplyPartition] = request.Headers[HeaderReplyPartition]
+	}
+	return msg
+}
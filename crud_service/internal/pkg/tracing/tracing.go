@@ -0,0 +1,92 @@
+// This package sets up tracing of the services by OpenTelemetry. Spans are exported
+// by the exporter selected by the config, trace context is propagated in W3C format.
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters selected by the config
+const (
+	ExporterOTLP   = "otlp"
+	ExporterJaeger = "jaeger"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+var ErrUnknownExporter = errors.New("unknown tracing exporter")
+
+// Init sets the global tracer provider of the service and the propagator of trace context.
+// The exporter of cfg may be changed by the environment variable, spans are not recorded by the none exporter.
+// The returned function exports the remaining spans and stops the provider, it must be called before exit.
+func Init(ctx context.Context, service string, cfg config.TracingCfg) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporterName := cfg.Exporter
+	if name := os.Getenv(config.TracingExporterEnv); name != "" {
+		exporterName = name
+	}
+	exporter, err := newExporter(ctx, exporterName, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceNameKey.String(service),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "tracing resource")
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// the decision of the service starting the trace is kept by other services
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// newExporter returns nil exporter for the none exporter
+func newExporter(ctx context.Context, name string, cfg config.TracingCfg) (sdktrace.SpanExporter, error) {
+	switch name {
+	case ExporterOTLP:
+		return otlptracegrpc.New(ctx,
+			otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint),
+			otlptracegrpc.WithInsecure(),
+		)
+	case ExporterJaeger:
+		return jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(cfg.JaegerEndpoint)))
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterNone:
+		return nil, nil
+	}
+	return nil, errors.Wrapf(ErrUnknownExporter, "exporter: [%s]", name)
+}
+
+// Fail marks the span as failed with the description, err is recorded as the event of the span if it is set
+func Fail(span trace.Span, err error, description string) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.SetStatus(codes.Error, description)
+}
@@ -0,0 +1,193 @@
+package userfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+// maxLineSize limits length of a line of NDJSON file
+const maxLineSize = 1024 * 1024
+
+var ErrMissingEmail = errors.New("email column is missing")
+
+// Record is the user read from the line of the file. Only email, name, role and password are read,
+// other exported fields are ignored, so that exported files can be imported back.
+type Record struct {
+	Line int
+	User models.User
+}
+
+// RowError is the error of a single line, reading can be continued after it
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line [%d]: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Decoder reads users from the file. Decode returns io.EOF after the last user and
+// *RowError if the line cannot be read, other errors mean that the file cannot be read further.
+type Decoder interface {
+	Decode() (Record, error)
+}
+
+func NewDecoder(r io.Reader, format Format, header Header) (Decoder, error) {
+	fields, err := header.fields()
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		return &csvDecoder{reader: reader, fields: fields}, nil
+	case FormatNDJSON:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+		return &ndjsonDecoder{scanner: scanner, fields: fields}, nil
+	}
+	return nil, errors.Wrapf(ErrUnknownFormat, "format: [%s]", format)
+}
+
+type csvDecoder struct {
+	reader *csv.Reader
+	fields map[string]string
+	// columns are fields of the file's columns in their order
+	columns []string
+}
+
+func (d *csvDecoder) Decode() (Record, error) {
+	if d.columns == nil {
+		if err := d.readHeader(); err != nil {
+			return Record{}, err
+		}
+	}
+
+	values, err := d.reader.Read()
+	if err == io.EOF {
+		return Record{}, io.EOF
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return Record{}, &RowError{Line: parseErr.Line, Err: parseErr.Err}
+	}
+	if err != nil {
+		return Record{}, errors.Wrap(err, "read csv")
+	}
+
+	line, _ := d.reader.FieldPos(0)
+	record := Record{Line: line}
+	for i, value := range values {
+		if i < len(d.columns) {
+			setField(&record.User, d.columns[i], value)
+		}
+	}
+	return record, nil
+}
+
+func (d *csvDecoder) readHeader() error {
+	names, err := d.reader.Read()
+	if err == io.EOF {
+		return io.EOF
+	}
+	if err != nil {
+		return errors.Wrap(err, "read csv header")
+	}
+
+	d.columns = make([]string, 0, len(names))
+	var hasEmail bool
+	for _, name := range names {
+		field, ok := d.fields[name]
+		if !ok {
+			return errors.Wrapf(ErrUnknownField, "column: [%s]", name)
+		}
+		hasEmail = hasEmail || field == FieldEmail
+		d.columns = append(d.columns, field)
+	}
+	if !hasEmail {
+		return ErrMissingEmail
+	}
+	return nil
+}
+
+type ndjsonDecoder struct {
+	scanner *bufio.Scanner
+	fields  map[string]string
+	line    int
+}
+
+func (d *ndjsonDecoder) Decode() (Record, error) {
+	for d.scanner.Scan() {
+		d.line++
+		data := bytes.TrimSpace(d.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var object map[string]interface{}
+		if err := json.Unmarshal(data, &object); err != nil {
+			return Record{}, &RowError{Line: d.line, Err: err}
+		}
+
+		record := Record{Line: d.line}
+		for key, value := range object {
+			field, ok := d.fields[key]
+			if !ok {
+				return Record{}, &RowError{Line: d.line, Err: errors.Wrapf(ErrUnknownField, "key: [%s]", key)}
+			}
+			if value == nil {
+				continue
+			}
+			if text, ok := value.(string); ok {
+				setField(&record.User, field, text)
+			} else if isImportField(field) {
+				return Record{}, &RowError{Line: d.line, Err: fmt.Errorf("value of [%s] is not a string", key)}
+			}
+		}
+		if record.User.Email == "" {
+			return Record{}, &RowError{Line: d.line, Err: ErrMissingEmail}
+		}
+		return record, nil
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		return Record{}, errors.Wrap(err, "read ndjson")
+	}
+	return Record{}, io.EOF
+}
+
+func isImportField(field string) bool {
+	switch field {
+	case FieldEmail, FieldName, FieldRole, FieldPassword:
+		return true
+	}
+	return false
+}
+
+func setField(user *models.User, field, value string) {
+	switch field {
+	case FieldEmail:
+		user.Email = value
+	case FieldName:
+		user.Name = value
+	case FieldRole:
+		user.Role = value
+	case FieldPassword:
+		user.Password = value
+	}
+}
@@ -0,0 +1,138 @@
+package userfile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+// Encoder writes users to the file, Flush must be called after the last user
+type Encoder interface {
+	Encode(user models.User) error
+	Flush() error
+}
+
+// NewEncoder returns encoder writing the given fields of users, ExportFields are written if fields are empty
+func NewEncoder(w io.Writer, format Format, fields []string, header Header) (Encoder, error) {
+	if len(fields) == 0 {
+		fields = ExportFields
+	}
+	if err := ValidateExportFields(fields); err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatCSV:
+		return &csvEncoder{writer: csv.NewWriter(w), fields: fields, header: header}, nil
+	case FormatNDJSON:
+		return &ndjsonEncoder{encoder: json.NewEncoder(w), fields: fields, header: header}, nil
+	}
+	return nil, errors.Wrapf(ErrUnknownFormat, "format: [%s]", format)
+}
+
+type csvEncoder struct {
+	writer        *csv.Writer
+	fields        []string
+	header        Header
+	headerWritten bool
+}
+
+func (e *csvEncoder) Encode(user models.User) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	record := make([]string, 0, len(e.fields))
+	for _, field := range e.fields {
+		record = append(record, fieldValue(user, field))
+	}
+	if err := e.writer.Write(record); err != nil {
+		return errors.Wrapf(err, "write csv user-id: [%d]", user.Id)
+	}
+	return nil
+}
+
+// Flush writes the header even if there are no users, so that the file can be imported
+func (e *csvEncoder) Flush() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+	columns := make([]string, 0, len(e.fields))
+	for _, field := range e.fields {
+		columns = append(columns, e.header.column(field))
+	}
+	if err := e.writer.Write(columns); err != nil {
+		return errors.Wrap(err, "write csv header")
+	}
+	e.headerWritten = true
+	return nil
+}
+
+type ndjsonEncoder struct {
+	encoder *json.Encoder
+	fields  []string
+	header  Header
+}
+
+func (e *ndjsonEncoder) Encode(user models.User) error {
+	object := make(map[string]interface{}, len(e.fields))
+	for _, field := range e.fields {
+		var value interface{}
+		switch field {
+		case FieldId:
+			value = user.Id
+		case FieldVersion:
+			value = user.Version
+		case FieldDeletedAt:
+			if user.IsDeleted() {
+				value = user.DeletedAt.UTC().Format(time.RFC3339)
+			}
+		default:
+			value = fieldValue(user, field)
+		}
+		object[e.header.column(field)] = value
+	}
+
+	// json encoder ends every object with a new line
+	if err := e.encoder.Encode(object); err != nil {
+		return errors.Wrapf(err, "write ndjson user-id: [%d]", user.Id)
+	}
+	return nil
+}
+
+func (e *ndjsonEncoder) Flush() error {
+	return nil
+}
+
+func fieldValue(user models.User, field string) string {
+	switch field {
+	case FieldId:
+		return strconv.FormatUint(uint64(user.Id), 10)
+	case FieldEmail:
+		return user.Email
+	case FieldName:
+		return user.Name
+	case FieldRole:
+		return user.Role
+	case FieldDeletedAt:
+		if user.IsDeleted() {
+			return user.DeletedAt.UTC().Format(time.RFC3339)
+		}
+	case FieldVersion:
+		return strconv.FormatUint(user.Version, 10)
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+// This package reads and writes users in files of bulk import and export. Supported formats are CSV
+// with a header row and NDJSON with one JSON object per line. Columns of CSV and keys of NDJSON
+// are named by fields of the user unless the header maps fields to other names.
+package userfile
+
+import (
+	"github.com/pkg/errors"
+)
+
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+// Fields of the user which can be written to and read from files
+const (
+	FieldId        = "id"
+	FieldEmail     = "email"
+	FieldName      = "name"
+	FieldRole      = "role"
+	FieldPassword  = "password"
+	FieldDeletedAt = "deleted_at"
+	FieldVersion   = "version"
+)
+
+// ExportFields are written to files by default. Password hashes are never exported.
+var ExportFields = []string{FieldId, FieldEmail, FieldName, FieldRole, FieldDeletedAt, FieldVersion}
+
+var ErrUnknownFormat = errors.New("unknown file format")
+var ErrUnknownField = errors.New("unknown field")
+
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case FormatCSV, FormatNDJSON:
+		return Format(format), nil
+	}
+	return "", errors.Wrapf(ErrUnknownFormat, "format: [%s]", format)
+}
+
+// ValidateExportFields checks that the fields can be exported
+func ValidateExportFields(fields []string) error {
+	for _, field := range fields {
+		if !isExportField(field) {
+			return errors.Wrapf(ErrUnknownField, "field: [%s]", field)
+		}
+	}
+	return nil
+}
+
+func isExportField(field string) bool {
+	for _, exportField := range ExportFields {
+		if field == exportField {
+			return true
+		}
+	}
+	return false
+}
+
+// Header maps fields of the user to names of columns or keys in the file.
+// Fields which are not mapped are named as they are.
+type Header map[string]string
+
+func (h Header) column(field string) string {
+	if column, ok := h[field]; ok && column != "" {
+		return column
+	}
+	return field
+}
+
+// fields returns the reverse mapping of the header from names of columns to fields
+func (h Header) fields() (map[string]string, error) {
+	result := make(map[string]string, len(ExportFields)+1)
+	for _, field := range append([]string{FieldPassword}, ExportFields...) {
+		result[h.column(field)] = field
+	}
+	for field := range h {
+		if field != FieldPassword && !isExportField(field) {
+			return nil, errors.Wrapf(ErrUnknownField, "header field: [%s]", field)
+		}
+	}
+	return result, nil
+}
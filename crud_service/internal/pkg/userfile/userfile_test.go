@@ -0,0 +1,205 @@
+package userfile
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
+)
+
+func testUsers() []models.User {
+	deletedAt := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	return []models.User{
+		{Id: 1, Email: "test01@dummy.com", Name: "Test Tester", Role: "Admin", Password: "hash", Version: 2},
+		{Id: 2, Email: "test02@dummy.com", Name: "Test, Quoted", Role: "User", DeletedAt: &deletedAt, Version: 1},
+	}
+}
+
+func encode(t *testing.T, format Format, fields []string, header Header) string {
+	var buffer bytes.Buffer
+	encoder, err := NewEncoder(&buffer, format, fields, header)
+	require.NoError(t, err)
+	for _, user := range testUsers() {
+		require.NoError(t, encoder.Encode(user))
+	}
+	require.NoError(t, encoder.Flush())
+	return buffer.String()
+}
+
+func decodeAll(t *testing.T, data string, format Format, header Header) ([]Record, []*RowError) {
+	decoder, err := NewDecoder(strings.NewReader(data), format, header)
+	require.NoError(t, err)
+
+	var records []Record
+	var rowErrors []*RowError
+	for {
+		record, err := decoder.Decode()
+		if err == io.EOF {
+			return records, rowErrors
+		}
+		var rowErr *RowError
+		if errors.As(err, &rowErr) {
+			rowErrors = append(rowErrors, rowErr)
+			continue
+		}
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	t.Run("csv", func(t *testing.T) {
+		// act
+		data := encode(t, FormatCSV, nil, nil)
+
+		// assert
+		assert.Equal(t, "id,email,name,role,deleted_at,version\n"+
+			"1,test01@dummy.com,Test Tester,Admin,,2\n"+
+			"2,test02@dummy.com,\"Test, Quoted\",User,2022-11-01T12:00:00Z,1\n", data)
+	})
+	t.Run("ndjson", func(t *testing.T) {
+		// act
+		data := encode(t, FormatNDJSON, []string{FieldId, FieldEmail, FieldDeletedAt}, Header{FieldEmail: "mail"})
+
+		// assert
+		assert.Equal(t, "{\"deleted_at\":null,\"id\":1,\"mail\":\"test01@dummy.com\"}\n"+
+			"{\"deleted_at\":\"2022-11-01T12:00:00Z\",\"id\":2,\"mail\":\"test02@dummy.com\"}\n", data)
+	})
+	t.Run("csv without users", func(t *testing.T) {
+		// arrange
+		var buffer bytes.Buffer
+		encoder, err := NewEncoder(&buffer, FormatCSV, []string{FieldEmail, FieldName}, nil)
+		require.NoError(t, err)
+
+		// act
+		err = encoder.Flush()
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, "email,name\n", buffer.String())
+	})
+	t.Run("error", func(t *testing.T) {
+		t.Run("password", func(t *testing.T) {
+			// act
+			_, err := NewEncoder(io.Discard, FormatCSV, []string{FieldEmail, FieldPassword}, nil)
+
+			// assert
+			assert.ErrorIs(t, err, ErrUnknownField)
+		})
+		t.Run("format", func(t *testing.T) {
+			// act
+			_, err := NewEncoder(io.Discard, Format("xml"), nil, nil)
+
+			// assert
+			assert.ErrorIs(t, err, ErrUnknownFormat)
+		})
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	// csv has the header row before users
+	firstLines := map[Format]int{FormatCSV: 2, FormatNDJSON: 1}
+	for format, firstLine := range firstLines {
+		format, firstLine := format, firstLine
+		t.Run(string(format), func(t *testing.T) {
+			// arrange
+			header := Header{FieldEmail: "E-mail", FieldName: "Full name"}
+			data := encode(t, format, nil, header)
+
+			// act
+			records, rowErrors := decodeAll(t, data, format, header)
+
+			// assert
+			require.Empty(t, rowErrors)
+			require.Len(t, records, 2)
+			for i, user := range testUsers() {
+				assert.Equal(t, firstLine+i, records[i].Line)
+				// only fields of import are read, password hashes are not exported
+				assert.Equal(t, models.User{Email: user.Email, Name: user.Name, Role: user.Role}, records[i].User)
+			}
+		})
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	t.Run("csv with password", func(t *testing.T) {
+		// arrange
+		data := "email,password,name\n" +
+			"test01@dummy.com,123456,Test Tester\n"
+
+		// act
+		records, rowErrors := decodeAll(t, data, FormatCSV, nil)
+
+		// assert
+		require.Empty(t, rowErrors)
+		assert.Equal(t, []Record{{
+			Line: 2,
+			User: models.User{Email: "test01@dummy.com", Name: "Test Tester", Password: "123456"},
+		}}, records)
+	})
+	t.Run("ndjson row errors", func(t *testing.T) {
+		// arrange
+		data := "{\"email\":\"test01@dummy.com\",\"role\":\"Admin\"}\n" +
+			"\n" +
+			"{\"email\":\n" +
+			"{\"email\":\"test02@dummy.com\",\"phone\":\"123\"}\n" +
+			"{\"email\":\"test03@dummy.com\",\"name\":1}\n" +
+			"{\"name\":\"Test Tester\"}\n" +
+			"{\"email\":\"test04@dummy.com\",\"id\":4}\n"
+
+		// act
+		records, rowErrors := decodeAll(t, data, FormatNDJSON, nil)
+
+		// assert
+		require.Len(t, records, 2)
+		assert.Equal(t, 1, records[0].Line)
+		assert.Equal(t, "test01@dummy.com", records[0].User.Email)
+		assert.Equal(t, 7, records[1].Line)
+		assert.Equal(t, "test04@dummy.com", records[1].User.Email)
+
+		lines := make([]int, 0, len(rowErrors))
+		for _, rowErr := range rowErrors {
+			lines = append(lines, rowErr.Line)
+		}
+		assert.Equal(t, []int{3, 4, 5, 6}, lines)
+		assert.ErrorIs(t, rowErrors[1], ErrUnknownField)
+		assert.ErrorIs(t, rowErrors[3], ErrMissingEmail)
+	})
+	t.Run("error", func(t *testing.T) {
+		t.Run("csv without email", func(t *testing.T) {
+			// arrange
+			decoder, err := NewDecoder(strings.NewReader("name,role\nTest Tester,Admin\n"), FormatCSV, nil)
+			require.NoError(t, err)
+
+			// act
+			_, err = decoder.Decode()
+
+			// assert
+			assert.ErrorIs(t, err, ErrMissingEmail)
+		})
+		t.Run("csv unknown column", func(t *testing.T) {
+			// arrange
+			decoder, err := NewDecoder(strings.NewReader("email,phone\n"), FormatCSV, nil)
+			require.NoError(t, err)
+
+			// act
+			_, err = decoder.Decode()
+
+			// assert
+			assert.ErrorIs(t, err, ErrUnknownField)
+		})
+		t.Run("unknown header field", func(t *testing.T) {
+			// act
+			_, err := NewDecoder(strings.NewReader(""), FormatCSV, Header{"phone": "tel"})
+
+			// assert
+			assert.ErrorIs(t, err, ErrUnknownField)
+		})
+	})
+}
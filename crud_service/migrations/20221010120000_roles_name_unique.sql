@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.roles ALTER COLUMN name SET NOT NULL;
+ALTER TABLE public.roles ADD CONSTRAINT roles_name_key UNIQUE (name);
+-- built-in roles are inserted with explicit ids, so the sequence has to be moved past them
+SELECT setval('roles_id_seq', (SELECT MAX(id) FROM public.roles));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.roles DROP CONSTRAINT IF EXISTS roles_name_key;
+ALTER TABLE public.roles ALTER COLUMN name DROP NOT NULL;
+
+-- +goose StatementEnd
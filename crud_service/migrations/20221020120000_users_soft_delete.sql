@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.users ADD COLUMN deleted_at TIMESTAMPTZ;
+-- email of soft deleted user can be taken by a new one
+ALTER TABLE public.users DROP CONSTRAINT users_email_key;
+CREATE UNIQUE INDEX users_email_key ON public.users (email) WHERE deleted_at IS NULL;
+CREATE INDEX users_deleted_at_idx ON public.users (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM public.users WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS public.users_deleted_at_idx;
+DROP INDEX IF EXISTS public.users_email_key;
+ALTER TABLE public.users ADD CONSTRAINT users_email_key UNIQUE (email);
+ALTER TABLE public.users DROP COLUMN deleted_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- events are kept after the user is purged, so target_id does not reference users
+CREATE TABLE IF NOT EXISTS public.audit_events (
+    id          BIGSERIAL PRIMARY KEY,
+    actor_id    BIGINT       NOT NULL DEFAULT 0,
+    actor_email VARCHAR(255) NOT NULL DEFAULT '',
+    action      VARCHAR(32)  NOT NULL,
+    target_id   BIGINT       NOT NULL,
+    changes     JSONB        NOT NULL DEFAULT '{}',
+    request_id  VARCHAR(64)  NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+CREATE INDEX audit_events_target_id_idx ON public.audit_events (target_id);
+CREATE INDEX audit_events_actor_id_idx ON public.audit_events (actor_id);
+CREATE INDEX audit_events_created_at_idx ON public.audit_events (created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.audit_events;
+
+-- +goose StatementEnd
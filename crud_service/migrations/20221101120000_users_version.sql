@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- version is incremented by every update, so concurrent updates of the same user can be detected
+ALTER TABLE public.users ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.users DROP COLUMN version;
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- events are written in the transaction of the user's change and removed by the relay after publishing to Kafka
+CREATE TABLE IF NOT EXISTS public.user_events_outbox (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    BIGINT      NOT NULL,
+    event_type VARCHAR(32) NOT NULL,
+    payload    BYTEA       NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.user_events_outbox;
+
+-- +goose StatementEnd
@@ -10,6 +10,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,19 +23,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type BackendUserCreateRequest struct {
+// What to do with the user if its email belongs to an existing user or to a previous user of the stream
+type BackendUsersImportRequest_DuplicatePolicy int32
+
+const (
+	BackendUsersImportRequest_FAIL   BackendUsersImportRequest_DuplicatePolicy = 0 // the user is reported as failed
+	BackendUsersImportRequest_SKIP   BackendUsersImportRequest_DuplicatePolicy = 1 // the user is skipped
+	BackendUsersImportRequest_UPDATE BackendUsersImportRequest_DuplicatePolicy = 2 // name, role and password, if it is set, of the existing user are updated
+)
+
+// Enum value maps for BackendUsersImportRequest_DuplicatePolicy.
+var (
+	BackendUsersImportRequest_DuplicatePolicy_name = map[int32]string{
+		0: "FAIL",
+		1: "SKIP",
+		2: "UPDATE",
+	}
+	BackendUsersImportRequest_DuplicatePolicy_value = map[string]int32{
+		"FAIL":   0,
+		"SKIP":   1,
+		"UPDATE": 2,
+	}
+)
+
+func (x BackendUsersImportRequest_DuplicatePolicy) Enum() *BackendUsersImportRequest_DuplicatePolicy {
+	p := new(BackendUsersImportRequest_DuplicatePolicy)
+	*p = x
+	return p
+}
+
+func (x BackendUsersImportRequest_DuplicatePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackendUsersImportRequest_DuplicatePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_backend_proto_enumTypes[0].Descriptor()
+}
+
+func (BackendUsersImportRequest_DuplicatePolicy) Type() protoreflect.EnumType {
+	return &file_api_backend_proto_enumTypes[0]
+}
+
+func (x BackendUsersImportRequest_DuplicatePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackendUsersImportRequest_DuplicatePolicy.Descriptor instead.
+func (BackendUsersImportRequest_DuplicatePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{26, 0}
+}
+
+type BackendLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *BackendUserCreateRequest) Reset() {
-	*x = BackendUserCreateRequest{}
+func (x *BackendLoginRequest) Reset() {
+	*x = BackendLoginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -41,13 +91,13 @@ func (x *BackendUserCreateRequest) Reset() {
 	}
 }
 
-func (x *BackendUserCreateRequest) String() string {
+func (x *BackendLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserCreateRequest) ProtoMessage() {}
+func (*BackendLoginRequest) ProtoMessage() {}
 
-func (x *BackendUserCreateRequest) ProtoReflect() protoreflect.Message {
+func (x *BackendLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -59,49 +109,37 @@ func (x *BackendUserCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserCreateRequest.ProtoReflect.Descriptor instead.
-func (*BackendUserCreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendLoginRequest.ProtoReflect.Descriptor instead.
+func (*BackendLoginRequest) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *BackendUserCreateRequest) GetEmail() string {
+func (x *BackendLoginRequest) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-func (x *BackendUserCreateRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BackendUserCreateRequest) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
-}
-
-func (x *BackendUserCreateRequest) GetPassword() string {
+func (x *BackendLoginRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-type BackendUserCreateResponse struct {
+type BackendLoginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccessToken  string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn    uint64 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 }
 
-func (x *BackendUserCreateResponse) Reset() {
-	*x = BackendUserCreateResponse{}
+func (x *BackendLoginResponse) Reset() {
+	*x = BackendLoginResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -109,13 +147,13 @@ func (x *BackendUserCreateResponse) Reset() {
 	}
 }
 
-func (x *BackendUserCreateResponse) String() string {
+func (x *BackendLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserCreateResponse) ProtoMessage() {}
+func (*BackendLoginResponse) ProtoMessage() {}
 
-func (x *BackendUserCreateResponse) ProtoReflect() protoreflect.Message {
+func (x *BackendLoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -127,30 +165,42 @@ func (x *BackendUserCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserCreateResponse.ProtoReflect.Descriptor instead.
-func (*BackendUserCreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendLoginResponse.ProtoReflect.Descriptor instead.
+func (*BackendLoginResponse) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *BackendUserCreateResponse) GetId() uint64 {
+func (x *BackendLoginResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Id
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *BackendLoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *BackendLoginResponse) GetExpiresIn() uint64 {
+	if x != nil {
+		return x.ExpiresIn
 	}
 	return 0
 }
 
-type BackendUserListRequest struct {
+type BackendRefreshRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RecPerPage *uint64                              `protobuf:"varint,1,opt,name=rec_per_page,json=recPerPage,proto3,oneof" json:"rec_per_page,omitempty"`
-	PageNum    *uint64                              `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3,oneof" json:"page_num,omitempty"`
-	Order      *BackendUserListRequest_SortingOrder `protobuf:"bytes,3,opt,name=order,proto3,oneof" json:"order,omitempty"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
-func (x *BackendUserListRequest) Reset() {
-	*x = BackendUserListRequest{}
+func (x *BackendRefreshRequest) Reset() {
+	*x = BackendRefreshRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -158,13 +208,13 @@ func (x *BackendUserListRequest) Reset() {
 	}
 }
 
-func (x *BackendUserListRequest) String() string {
+func (x *BackendRefreshRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserListRequest) ProtoMessage() {}
+func (*BackendRefreshRequest) ProtoMessage() {}
 
-func (x *BackendUserListRequest) ProtoReflect() protoreflect.Message {
+func (x *BackendRefreshRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -176,42 +226,30 @@ func (x *BackendUserListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserListRequest.ProtoReflect.Descriptor instead.
-func (*BackendUserListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendRefreshRequest.ProtoReflect.Descriptor instead.
+func (*BackendRefreshRequest) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *BackendUserListRequest) GetRecPerPage() uint64 {
-	if x != nil && x.RecPerPage != nil {
-		return *x.RecPerPage
-	}
-	return 0
-}
-
-func (x *BackendUserListRequest) GetPageNum() uint64 {
-	if x != nil && x.PageNum != nil {
-		return *x.PageNum
-	}
-	return 0
-}
-
-func (x *BackendUserListRequest) GetOrder() *BackendUserListRequest_SortingOrder {
+func (x *BackendRefreshRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Order
+		return x.RefreshToken
 	}
-	return nil
+	return ""
 }
 
-type BackendUserListResponse struct {
+type BackendRefreshResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*BackendUserListResponse_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	AccessToken  string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn    uint64 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 }
 
-func (x *BackendUserListResponse) Reset() {
-	*x = BackendUserListResponse{}
+func (x *BackendRefreshResponse) Reset() {
+	*x = BackendRefreshResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -219,13 +257,13 @@ func (x *BackendUserListResponse) Reset() {
 	}
 }
 
-func (x *BackendUserListResponse) String() string {
+func (x *BackendRefreshResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserListResponse) ProtoMessage() {}
+func (*BackendRefreshResponse) ProtoMessage() {}
 
-func (x *BackendUserListResponse) ProtoReflect() protoreflect.Message {
+func (x *BackendRefreshResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -237,33 +275,42 @@ func (x *BackendUserListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserListResponse.ProtoReflect.Descriptor instead.
-func (*BackendUserListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendRefreshResponse.ProtoReflect.Descriptor instead.
+func (*BackendRefreshResponse) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *BackendUserListResponse) GetUsers() []*BackendUserListResponse_User {
+func (x *BackendRefreshResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Users
+		return x.AccessToken
 	}
-	return nil
+	return ""
 }
 
-type BackendUserUpdateRequest struct {
+func (x *BackendRefreshResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *BackendRefreshResponse) GetExpiresIn() uint64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type BackendLogoutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email       string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Role        string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	Password    string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
-	Oldpassword string `protobuf:"bytes,6,opt,name=oldpassword,proto3" json:"oldpassword,omitempty"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
-func (x *BackendUserUpdateRequest) Reset() {
-	*x = BackendUserUpdateRequest{}
+func (x *BackendLogoutRequest) Reset() {
+	*x = BackendLogoutRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -271,13 +318,13 @@ func (x *BackendUserUpdateRequest) Reset() {
 	}
 }
 
-func (x *BackendUserUpdateRequest) String() string {
+func (x *BackendLogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserUpdateRequest) ProtoMessage() {}
+func (*BackendLogoutRequest) ProtoMessage() {}
 
-func (x *BackendUserUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *BackendLogoutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -289,61 +336,26 @@ func (x *BackendUserUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserUpdateRequest.ProtoReflect.Descriptor instead.
-func (*BackendUserUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendLogoutRequest.ProtoReflect.Descriptor instead.
+func (*BackendLogoutRequest) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BackendUserUpdateRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *BackendUserUpdateRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *BackendUserUpdateRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BackendUserUpdateRequest) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
-}
-
-func (x *BackendUserUpdateRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
-func (x *BackendUserUpdateRequest) GetOldpassword() string {
+func (x *BackendLogoutRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Oldpassword
+		return x.RefreshToken
 	}
 	return ""
 }
 
-type BackendUserUpdateResponse struct {
+type BackendLogoutResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *BackendUserUpdateResponse) Reset() {
-	*x = BackendUserUpdateResponse{}
+func (x *BackendLogoutResponse) Reset() {
+	*x = BackendLogoutResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -351,13 +363,13 @@ func (x *BackendUserUpdateResponse) Reset() {
 	}
 }
 
-func (x *BackendUserUpdateResponse) String() string {
+func (x *BackendLogoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserUpdateResponse) ProtoMessage() {}
+func (*BackendLogoutResponse) ProtoMessage() {}
 
-func (x *BackendUserUpdateResponse) ProtoReflect() protoreflect.Message {
+func (x *BackendLogoutResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -369,22 +381,24 @@ func (x *BackendUserUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserUpdateResponse.ProtoReflect.Descriptor instead.
-func (*BackendUserUpdateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendLogoutResponse.ProtoReflect.Descriptor instead.
+func (*BackendLogoutResponse) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{5}
 }
 
-type BackendUserDeleteRequest struct {
+type BackendUserCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *BackendUserDeleteRequest) Reset() {
-	*x = BackendUserDeleteRequest{}
+func (x *BackendUserCreateRequest) Reset() {
+	*x = BackendUserCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -392,13 +406,13 @@ func (x *BackendUserDeleteRequest) Reset() {
 	}
 }
 
-func (x *BackendUserDeleteRequest) String() string {
+func (x *BackendUserCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserDeleteRequest) ProtoMessage() {}
+func (*BackendUserCreateRequest) ProtoMessage() {}
 
-func (x *BackendUserDeleteRequest) ProtoReflect() protoreflect.Message {
+func (x *BackendUserCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -410,33 +424,49 @@ func (x *BackendUserDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserDeleteRequest.ProtoReflect.Descriptor instead.
-func (*BackendUserDeleteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendUserCreateRequest.ProtoReflect.Descriptor instead.
+func (*BackendUserCreateRequest) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *BackendUserDeleteRequest) GetId() uint64 {
+func (x *BackendUserCreateRequest) GetEmail() string {
 	if x != nil {
-		return x.Id
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-func (x *BackendUserDeleteRequest) GetPassword() string {
+func (x *BackendUserCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BackendUserCreateRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BackendUserCreateRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-type BackendUserDeleteResponse struct {
+type BackendUserCreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *BackendUserDeleteResponse) Reset() {
-	*x = BackendUserDeleteResponse{}
+func (x *BackendUserCreateResponse) Reset() {
+	*x = BackendUserCreateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -444,13 +474,13 @@ func (x *BackendUserDeleteResponse) Reset() {
 	}
 }
 
-func (x *BackendUserDeleteResponse) String() string {
+func (x *BackendUserCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserDeleteResponse) ProtoMessage() {}
+func (*BackendUserCreateResponse) ProtoMessage() {}
 
-func (x *BackendUserDeleteResponse) ProtoReflect() protoreflect.Message {
+func (x *BackendUserCreateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,21 +492,34 @@ func (x *BackendUserDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserDeleteResponse.ProtoReflect.Descriptor instead.
-func (*BackendUserDeleteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendUserCreateResponse.ProtoReflect.Descriptor instead.
+func (*BackendUserCreateResponse) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{7}
 }
 
-type BackendUserGetRequest struct {
+func (x *BackendUserCreateResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BackendUserListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecPerPage *uint64                              `protobuf:"varint,1,opt,name=rec_per_page,json=recPerPage,proto3,oneof" json:"rec_per_page,omitempty"`
+	PageNum    *uint64                              `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3,oneof" json:"page_num,omitempty"`
+	Order      *BackendUserListRequest_SortingOrder `protobuf:"bytes,3,opt,name=order,proto3,oneof" json:"order,omitempty"`
+	Filter     *BackendUserListRequest_Filter       `protobuf:"bytes,4,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Switches to keyset pagination, page_num is ignored. Empty token requests the first page,
+	// the following pages are requested with next_page_token of the previous response.
+	PageToken *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
 }
 
-func (x *BackendUserGetRequest) Reset() {
-	*x = BackendUserGetRequest{}
+func (x *BackendUserListRequest) Reset() {
+	*x = BackendUserListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,13 +527,13 @@ func (x *BackendUserGetRequest) Reset() {
 	}
 }
 
-func (x *BackendUserGetRequest) String() string {
+func (x *BackendUserListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserGetRequest) ProtoMessage() {}
+func (*BackendUserListRequest) ProtoMessage() {}
 
-func (x *BackendUserGetRequest) ProtoReflect() protoreflect.Message {
+func (x *BackendUserListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,31 +545,62 @@ func (x *BackendUserGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserGetRequest.ProtoReflect.Descriptor instead.
-func (*BackendUserGetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendUserListRequest.ProtoReflect.Descriptor instead.
+func (*BackendUserListRequest) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BackendUserGetRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
+func (x *BackendUserListRequest) GetRecPerPage() uint64 {
+	if x != nil && x.RecPerPage != nil {
+		return *x.RecPerPage
 	}
 	return 0
 }
 
-type BackendUserGetResponse struct {
+func (x *BackendUserListRequest) GetPageNum() uint64 {
+	if x != nil && x.PageNum != nil {
+		return *x.PageNum
+	}
+	return 0
+}
+
+func (x *BackendUserListRequest) GetOrder() *BackendUserListRequest_SortingOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *BackendUserListRequest) GetFilter() *BackendUserListRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BackendUserListRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type BackendUserListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Role  string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Users []*BackendUserListResponse_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Set in keyset pagination mode when there are more users
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of users matching the filter
+	TotalCount uint64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageCount  uint64 `protobuf:"varint,4,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	HasMore    bool   `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 }
 
-func (x *BackendUserGetResponse) Reset() {
-	*x = BackendUserGetResponse{}
+func (x *BackendUserListResponse) Reset() {
+	*x = BackendUserListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,13 +608,13 @@ func (x *BackendUserGetResponse) Reset() {
 	}
 }
 
-func (x *BackendUserGetResponse) String() string {
+func (x *BackendUserListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserGetResponse) ProtoMessage() {}
+func (*BackendUserListResponse) ProtoMessage() {}
 
-func (x *BackendUserGetResponse) ProtoReflect() protoreflect.Message {
+func (x *BackendUserListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -552,52 +626,64 @@ func (x *BackendUserGetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserGetResponse.ProtoReflect.Descriptor instead.
-func (*BackendUserGetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendUserListResponse.ProtoReflect.Descriptor instead.
+func (*BackendUserListResponse) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BackendUserGetResponse) GetId() uint64 {
+func (x *BackendUserListResponse) GetUsers() []*BackendUserListResponse_User {
 	if x != nil {
-		return x.Id
+		return x.Users
 	}
-	return 0
+	return nil
 }
 
-func (x *BackendUserGetResponse) GetEmail() string {
+func (x *BackendUserListResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Email
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *BackendUserGetResponse) GetName() string {
+func (x *BackendUserListResponse) GetTotalCount() uint64 {
 	if x != nil {
-		return x.Name
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *BackendUserGetResponse) GetRole() string {
+func (x *BackendUserListResponse) GetPageCount() uint64 {
 	if x != nil {
-		return x.Role
+		return x.PageCount
 	}
-	return ""
+	return 0
 }
 
-type BackendUsersAddRequest struct {
+func (x *BackendUserListResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type BackendUserUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email       string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role        string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Password    string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	Oldpassword string `protobuf:"bytes,6,opt,name=oldpassword,proto3" json:"oldpassword,omitempty"`
+	// Version of the user returned by UserGet or UserList. The update is rejected with ABORTED
+	// if the user has been changed since. The version is not checked if it is not set.
+	Version *uint64 `protobuf:"varint,7,opt,name=version,proto3,oneof" json:"version,omitempty"`
 }
 
-func (x *BackendUsersAddRequest) Reset() {
-	*x = BackendUsersAddRequest{}
+func (x *BackendUserUpdateRequest) Reset() {
+	*x = BackendUserUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -605,13 +691,13 @@ func (x *BackendUsersAddRequest) Reset() {
 	}
 }
 
-func (x *BackendUsersAddRequest) String() string {
+func (x *BackendUserUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUsersAddRequest) ProtoMessage() {}
+func (*BackendUserUpdateRequest) ProtoMessage() {}
 
-func (x *BackendUsersAddRequest) ProtoReflect() protoreflect.Message {
+func (x *BackendUserUpdateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -623,49 +709,70 @@ func (x *BackendUsersAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUsersAddRequest.ProtoReflect.Descriptor instead.
-func (*BackendUsersAddRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendUserUpdateRequest.ProtoReflect.Descriptor instead.
+func (*BackendUserUpdateRequest) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BackendUsersAddRequest) GetEmail() string {
+func (x *BackendUserUpdateRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendUserUpdateRequest) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-func (x *BackendUsersAddRequest) GetName() string {
+func (x *BackendUserUpdateRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *BackendUsersAddRequest) GetRole() string {
+func (x *BackendUserUpdateRequest) GetRole() string {
 	if x != nil {
 		return x.Role
 	}
 	return ""
 }
 
-func (x *BackendUsersAddRequest) GetPassword() string {
+func (x *BackendUserUpdateRequest) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-type BackendUsersAddResponse struct {
+func (x *BackendUserUpdateRequest) GetOldpassword() string {
+	if x != nil {
+		return x.Oldpassword
+	}
+	return ""
+}
+
+func (x *BackendUserUpdateRequest) GetVersion() uint64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type BackendUserUpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *BackendUsersAddResponse) Reset() {
-	*x = BackendUsersAddResponse{}
+func (x *BackendUserUpdateResponse) Reset() {
+	*x = BackendUserUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -673,13 +780,13 @@ func (x *BackendUsersAddResponse) Reset() {
 	}
 }
 
-func (x *BackendUsersAddResponse) String() string {
+func (x *BackendUserUpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUsersAddResponse) ProtoMessage() {}
+func (*BackendUserUpdateResponse) ProtoMessage() {}
 
-func (x *BackendUsersAddResponse) ProtoReflect() protoreflect.Message {
+func (x *BackendUserUpdateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -691,29 +798,39 @@ func (x *BackendUsersAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUsersAddResponse.ProtoReflect.Descriptor instead.
-func (*BackendUsersAddResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackendUserUpdateResponse.ProtoReflect.Descriptor instead.
+func (*BackendUserUpdateResponse) Descriptor() ([]byte, []int) {
 	return file_api_backend_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BackendUsersAddResponse) GetId() uint64 {
+func (x *BackendUserUpdateResponse) GetVersion() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
 	return 0
 }
 
-type BackendUserListRequest_SortingOrder struct {
+// Only fields listed in update_mask are changed: email, name, role, password.
+// Old password is required only if email or password is changed.
+type BackendUserPatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Field      string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Descending bool   `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email       string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role        string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Password    string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	Oldpassword string                 `protobuf:"bytes,6,opt,name=oldpassword,proto3" json:"oldpassword,omitempty"`
+	UpdateMask  *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Version of the user returned by UserGet or UserList. The update is rejected with ABORTED
+	// if the user has been changed since. The version is not checked if it is not set.
+	Version *uint64 `protobuf:"varint,8,opt,name=version,proto3,oneof" json:"version,omitempty"`
 }
 
-func (x *BackendUserListRequest_SortingOrder) Reset() {
-	*x = BackendUserListRequest_SortingOrder{}
+func (x *BackendUserPatchRequest) Reset() {
+	*x = BackendUserPatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,13 +838,13 @@ func (x *BackendUserListRequest_SortingOrder) Reset() {
 	}
 }
 
-func (x *BackendUserListRequest_SortingOrder) String() string {
+func (x *BackendUserPatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserListRequest_SortingOrder) ProtoMessage() {}
+func (*BackendUserPatchRequest) ProtoMessage() {}
 
-func (x *BackendUserListRequest_SortingOrder) ProtoReflect() protoreflect.Message {
+func (x *BackendUserPatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -739,38 +856,77 @@ func (x *BackendUserListRequest_SortingOrder) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserListRequest_SortingOrder.ProtoReflect.Descriptor instead.
-func (*BackendUserListRequest_SortingOrder) Descriptor() ([]byte, []int) {
-	return file_api_backend_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use BackendUserPatchRequest.ProtoReflect.Descriptor instead.
+func (*BackendUserPatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BackendUserListRequest_SortingOrder) GetField() string {
+func (x *BackendUserPatchRequest) GetId() uint64 {
 	if x != nil {
-		return x.Field
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendUserPatchRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
 	}
 	return ""
 }
 
-func (x *BackendUserListRequest_SortingOrder) GetDescending() bool {
+func (x *BackendUserPatchRequest) GetName() string {
 	if x != nil {
-		return x.Descending
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-type BackendUserListResponse_User struct {
+func (x *BackendUserPatchRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BackendUserPatchRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *BackendUserPatchRequest) GetOldpassword() string {
+	if x != nil {
+		return x.Oldpassword
+	}
+	return ""
+}
+
+func (x *BackendUserPatchRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *BackendUserPatchRequest) GetVersion() uint64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type BackendUserPatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Role  string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *BackendUserListResponse_User) Reset() {
-	*x = BackendUserListResponse_User{}
+func (x *BackendUserPatchResponse) Reset() {
+	*x = BackendUserPatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_backend_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -778,13 +934,13 @@ func (x *BackendUserListResponse_User) Reset() {
 	}
 }
 
-func (x *BackendUserListResponse_User) String() string {
+func (x *BackendUserPatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackendUserListResponse_User) ProtoMessage() {}
+func (*BackendUserPatchResponse) ProtoMessage() {}
 
-func (x *BackendUserListResponse_User) ProtoReflect() protoreflect.Message {
+func (x *BackendUserPatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_backend_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,47 +952,2102 @@ func (x *BackendUserListResponse_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackendUserListResponse_User.ProtoReflect.Descriptor instead.
-func (*BackendUserListResponse_User) Descriptor() ([]byte, []int) {
-	return file_api_backend_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use BackendUserPatchResponse.ProtoReflect.Descriptor instead.
+func (*BackendUserPatchResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BackendUserListResponse_User) GetId() uint64 {
+func (x *BackendUserPatchResponse) GetVersion() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
 	return 0
 }
 
-func (x *BackendUserListResponse_User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+type BackendUserDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *BackendUserListResponse_User) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *BackendUserDeleteRequest) Reset() {
+	*x = BackendUserDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *BackendUserListResponse_User) GetRole() string {
-	if x != nil {
-		return x.Role
+func (x *BackendUserDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserDeleteRequest) ProtoMessage() {}
+
+func (x *BackendUserDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-var File_api_backend_proto protoreflect.FileDescriptor
+// Deprecated: Use BackendUserDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BackendUserDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BackendUserDeleteRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendUserDeleteRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type BackendUserDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BackendUserDeleteResponse) Reset() {
+	*x = BackendUserDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserDeleteResponse) ProtoMessage() {}
+
+func (x *BackendUserDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BackendUserDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{15}
+}
+
+type BackendUserGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+}
+
+func (x *BackendUserGetRequest) Reset() {
+	*x = BackendUserGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserGetRequest) ProtoMessage() {}
+
+func (x *BackendUserGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserGetRequest.ProtoReflect.Descriptor instead.
+func (*BackendUserGetRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BackendUserGetRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendUserGetRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type BackendUserGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role      string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version   uint64                 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *BackendUserGetResponse) Reset() {
+	*x = BackendUserGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserGetResponse) ProtoMessage() {}
+
+func (x *BackendUserGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserGetResponse.ProtoReflect.Descriptor instead.
+func (*BackendUserGetResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BackendUserGetResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendUserGetResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BackendUserGetResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BackendUserGetResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BackendUserGetResponse) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *BackendUserGetResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type BackendUserRestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BackendUserRestoreRequest) Reset() {
+	*x = BackendUserRestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserRestoreRequest) ProtoMessage() {}
+
+func (x *BackendUserRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserRestoreRequest.ProtoReflect.Descriptor instead.
+func (*BackendUserRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BackendUserRestoreRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BackendUserRestoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BackendUserRestoreResponse) Reset() {
+	*x = BackendUserRestoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserRestoreResponse) ProtoMessage() {}
+
+func (x *BackendUserRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserRestoreResponse.ProtoReflect.Descriptor instead.
+func (*BackendUserRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{19}
+}
+
+type BackendUserPurgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BackendUserPurgeRequest) Reset() {
+	*x = BackendUserPurgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserPurgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserPurgeRequest) ProtoMessage() {}
+
+func (x *BackendUserPurgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserPurgeRequest.ProtoReflect.Descriptor instead.
+func (*BackendUserPurgeRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BackendUserPurgeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BackendUserPurgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BackendUserPurgeResponse) Reset() {
+	*x = BackendUserPurgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserPurgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserPurgeResponse) ProtoMessage() {}
+
+func (x *BackendUserPurgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserPurgeResponse.ProtoReflect.Descriptor instead.
+func (*BackendUserPurgeResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{21}
+}
+
+type BackendUsersAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Atomic mode is set by the first message of the stream. In atomic mode all users are added
+	// in one transaction after the end of the stream, and the stream fails if any of them cannot be added.
+	// Otherwise every user is added separately and failures are returned in responses.
+	Atomic bool `protobuf:"varint,5,opt,name=atomic,proto3" json:"atomic,omitempty"`
+}
+
+func (x *BackendUsersAddRequest) Reset() {
+	*x = BackendUsersAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUsersAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUsersAddRequest) ProtoMessage() {}
+
+func (x *BackendUsersAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUsersAddRequest.ProtoReflect.Descriptor instead.
+func (*BackendUsersAddRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BackendUsersAddRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BackendUsersAddRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BackendUsersAddRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BackendUsersAddRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *BackendUsersAddRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+// One response is sent for every user of the stream in the same order
+type BackendUsersAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Status code of the user, OK means that the user has been added
+	Code    int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BackendUsersAddResponse) Reset() {
+	*x = BackendUsersAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUsersAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUsersAddResponse) ProtoMessage() {}
+
+func (x *BackendUsersAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUsersAddResponse.ProtoReflect.Descriptor instead.
+func (*BackendUsersAddResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BackendUsersAddResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendUsersAddResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BackendUsersAddResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BackendUsersExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *BackendUserListRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *BackendUsersExportRequest) Reset() {
+	*x = BackendUsersExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUsersExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUsersExportRequest) ProtoMessage() {}
+
+func (x *BackendUsersExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUsersExportRequest.ProtoReflect.Descriptor instead.
+func (*BackendUsersExportRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BackendUsersExportRequest) GetFilter() *BackendUserListRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Users are returned in pages ordered by id
+type BackendUsersExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*BackendUserListResponse_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *BackendUsersExportResponse) Reset() {
+	*x = BackendUsersExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUsersExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUsersExportResponse) ProtoMessage() {}
+
+func (x *BackendUsersExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUsersExportResponse.ProtoReflect.Descriptor instead.
+func (*BackendUsersExportResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BackendUsersExportResponse) GetUsers() []*BackendUserListResponse_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type BackendUsersImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Options are taken from the first message of the stream
+	Options *BackendUsersImportRequest_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	User    *BackendUsersImportRequest_User    `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *BackendUsersImportRequest) Reset() {
+	*x = BackendUsersImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUsersImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUsersImportRequest) ProtoMessage() {}
+
+func (x *BackendUsersImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUsersImportRequest.ProtoReflect.Descriptor instead.
+func (*BackendUsersImportRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BackendUsersImportRequest) GetOptions() *BackendUsersImportRequest_Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *BackendUsersImportRequest) GetUser() *BackendUsersImportRequest_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type BackendUsersImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun  bool                                `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Total   uint64                              `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Created uint64                              `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated uint64                              `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Skipped uint64                              `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed  uint64                              `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors  []*BackendUsersImportResponse_Error `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *BackendUsersImportResponse) Reset() {
+	*x = BackendUsersImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUsersImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUsersImportResponse) ProtoMessage() {}
+
+func (x *BackendUsersImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUsersImportResponse.ProtoReflect.Descriptor instead.
+func (*BackendUsersImportResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BackendUsersImportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BackendUsersImportResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BackendUsersImportResponse) GetCreated() uint64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BackendUsersImportResponse) GetUpdated() uint64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BackendUsersImportResponse) GetSkipped() uint64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *BackendUsersImportResponse) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BackendUsersImportResponse) GetErrors() []*BackendUsersImportResponse_Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type BackendRoleCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *BackendRoleCreateRequest) Reset() {
+	*x = BackendRoleCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRoleCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRoleCreateRequest) ProtoMessage() {}
+
+func (x *BackendRoleCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRoleCreateRequest.ProtoReflect.Descriptor instead.
+func (*BackendRoleCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BackendRoleCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BackendRoleCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BackendRoleCreateResponse) Reset() {
+	*x = BackendRoleCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRoleCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRoleCreateResponse) ProtoMessage() {}
+
+func (x *BackendRoleCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRoleCreateResponse.ProtoReflect.Descriptor instead.
+func (*BackendRoleCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BackendRoleCreateResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BackendRoleListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BackendRoleListRequest) Reset() {
+	*x = BackendRoleListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRoleListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRoleListRequest) ProtoMessage() {}
+
+func (x *BackendRoleListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRoleListRequest.ProtoReflect.Descriptor instead.
+func (*BackendRoleListRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{30}
+}
+
+type BackendRoleListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*BackendRoleListResponse_Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *BackendRoleListResponse) Reset() {
+	*x = BackendRoleListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRoleListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRoleListResponse) ProtoMessage() {}
+
+func (x *BackendRoleListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRoleListResponse.ProtoReflect.Descriptor instead.
+func (*BackendRoleListResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BackendRoleListResponse) GetRoles() []*BackendRoleListResponse_Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type BackendRoleUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *BackendRoleUpdateRequest) Reset() {
+	*x = BackendRoleUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRoleUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRoleUpdateRequest) ProtoMessage() {}
+
+func (x *BackendRoleUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRoleUpdateRequest.ProtoReflect.Descriptor instead.
+func (*BackendRoleUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BackendRoleUpdateRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendRoleUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BackendRoleUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BackendRoleUpdateResponse) Reset() {
+	*x = BackendRoleUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRoleUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRoleUpdateResponse) ProtoMessage() {}
+
+func (x *BackendRoleUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRoleUpdateResponse.ProtoReflect.Descriptor instead.
+func (*BackendRoleUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{33}
+}
+
+type BackendRoleDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BackendRoleDeleteRequest) Reset() {
+	*x = BackendRoleDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRoleDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRoleDeleteRequest) ProtoMessage() {}
+
+func (x *BackendRoleDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRoleDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BackendRoleDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BackendRoleDeleteRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BackendRoleDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BackendRoleDeleteResponse) Reset() {
+	*x = BackendRoleDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRoleDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRoleDeleteResponse) ProtoMessage() {}
+
+func (x *BackendRoleDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRoleDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BackendRoleDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{35}
+}
+
+type BackendAuditListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecPerPage *uint64                         `protobuf:"varint,1,opt,name=rec_per_page,json=recPerPage,proto3,oneof" json:"rec_per_page,omitempty"`
+	PageNum    *uint64                         `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3,oneof" json:"page_num,omitempty"`
+	Filter     *BackendAuditListRequest_Filter `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+}
+
+func (x *BackendAuditListRequest) Reset() {
+	*x = BackendAuditListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendAuditListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendAuditListRequest) ProtoMessage() {}
+
+func (x *BackendAuditListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendAuditListRequest.ProtoReflect.Descriptor instead.
+func (*BackendAuditListRequest) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BackendAuditListRequest) GetRecPerPage() uint64 {
+	if x != nil && x.RecPerPage != nil {
+		return *x.RecPerPage
+	}
+	return 0
+}
+
+func (x *BackendAuditListRequest) GetPageNum() uint64 {
+	if x != nil && x.PageNum != nil {
+		return *x.PageNum
+	}
+	return 0
+}
+
+func (x *BackendAuditListRequest) GetFilter() *BackendAuditListRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type BackendAuditListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Events from the newest to the oldest
+	Events     []*BackendAuditListResponse_Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount uint64                            `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageCount  uint64                            `protobuf:"varint,3,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	HasMore    bool                              `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (x *BackendAuditListResponse) Reset() {
+	*x = BackendAuditListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendAuditListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendAuditListResponse) ProtoMessage() {}
+
+func (x *BackendAuditListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendAuditListResponse.ProtoReflect.Descriptor instead.
+func (*BackendAuditListResponse) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BackendAuditListResponse) GetEvents() []*BackendAuditListResponse_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *BackendAuditListResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *BackendAuditListResponse) GetPageCount() uint64 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *BackendAuditListResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type BackendUserListRequest_SortingOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field      string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Descending bool   `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+}
+
+func (x *BackendUserListRequest_SortingOrder) Reset() {
+	*x = BackendUserListRequest_SortingOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserListRequest_SortingOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserListRequest_SortingOrder) ProtoMessage() {}
+
+func (x *BackendUserListRequest_SortingOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserListRequest_SortingOrder.ProtoReflect.Descriptor instead.
+func (*BackendUserListRequest_SortingOrder) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *BackendUserListRequest_SortingOrder) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *BackendUserListRequest_SortingOrder) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+// All conditions are combined with AND. Empty fields are not applied.
+// Substrings and prefixes are matched case-insensitively. Id range includes its bounds.
+type BackendUserListRequest_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles         []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	EmailContains string   `protobuf:"bytes,2,opt,name=email_contains,json=emailContains,proto3" json:"email_contains,omitempty"`
+	EmailPrefix   string   `protobuf:"bytes,3,opt,name=email_prefix,json=emailPrefix,proto3" json:"email_prefix,omitempty"`
+	NameContains  string   `protobuf:"bytes,4,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	NamePrefix    string   `protobuf:"bytes,5,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	IdFrom        uint64   `protobuf:"varint,6,opt,name=id_from,json=idFrom,proto3" json:"id_from,omitempty"`
+	IdTo          uint64   `protobuf:"varint,7,opt,name=id_to,json=idTo,proto3" json:"id_to,omitempty"`
+	// Soft deleted users are returned too
+	IncludeDeleted bool `protobuf:"varint,8,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+}
+
+func (x *BackendUserListRequest_Filter) Reset() {
+	*x = BackendUserListRequest_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserListRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserListRequest_Filter) ProtoMessage() {}
+
+func (x *BackendUserListRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserListRequest_Filter.ProtoReflect.Descriptor instead.
+func (*BackendUserListRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *BackendUserListRequest_Filter) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *BackendUserListRequest_Filter) GetEmailContains() string {
+	if x != nil {
+		return x.EmailContains
+	}
+	return ""
+}
+
+func (x *BackendUserListRequest_Filter) GetEmailPrefix() string {
+	if x != nil {
+		return x.EmailPrefix
+	}
+	return ""
+}
+
+func (x *BackendUserListRequest_Filter) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *BackendUserListRequest_Filter) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *BackendUserListRequest_Filter) GetIdFrom() uint64 {
+	if x != nil {
+		return x.IdFrom
+	}
+	return 0
+}
+
+func (x *BackendUserListRequest_Filter) GetIdTo() uint64 {
+	if x != nil {
+		return x.IdTo
+	}
+	return 0
+}
+
+func (x *BackendUserListRequest_Filter) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type BackendUserListResponse_User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role      string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version   uint64                 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *BackendUserListResponse_User) Reset() {
+	*x = BackendUserListResponse_User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUserListResponse_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUserListResponse_User) ProtoMessage() {}
+
+func (x *BackendUserListResponse_User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUserListResponse_User.ProtoReflect.Descriptor instead.
+func (*BackendUserListResponse_User) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *BackendUserListResponse_User) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendUserListResponse_User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BackendUserListResponse_User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BackendUserListResponse_User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BackendUserListResponse_User) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *BackendUserListResponse_User) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type BackendUsersImportRequest_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Users are checked and the report is returned, but nothing is changed
+	DryRun      bool                                      `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	OnDuplicate BackendUsersImportRequest_DuplicatePolicy `protobuf:"varint,2,opt,name=on_duplicate,json=onDuplicate,proto3,enum=ozon.dev.vldem.hw2.api.BackendUsersImportRequest_DuplicatePolicy" json:"on_duplicate,omitempty"`
+}
+
+func (x *BackendUsersImportRequest_Options) Reset() {
+	*x = BackendUsersImportRequest_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUsersImportRequest_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUsersImportRequest_Options) ProtoMessage() {}
+
+func (x *BackendUsersImportRequest_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUsersImportRequest_Options.ProtoReflect.Descriptor instead.
+func (*BackendUsersImportRequest_Options) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *BackendUsersImportRequest_Options) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BackendUsersImportRequest_Options) GetOnDuplicate() BackendUsersImportRequest_DuplicatePolicy {
+	if x != nil {
+		return x.OnDuplicate
+	}
+	return BackendUsersImportRequest_FAIL
+}
+
+type BackendUsersImportRequest_User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Line of the file
+	Line     uint64 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role     string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *BackendUsersImportRequest_User) Reset() {
+	*x = BackendUsersImportRequest_User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUsersImportRequest_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUsersImportRequest_User) ProtoMessage() {}
+
+func (x *BackendUsersImportRequest_User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUsersImportRequest_User.ProtoReflect.Descriptor instead.
+func (*BackendUsersImportRequest_User) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{26, 1}
+}
+
+func (x *BackendUsersImportRequest_User) GetLine() uint64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *BackendUsersImportRequest_User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BackendUsersImportRequest_User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BackendUsersImportRequest_User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BackendUsersImportRequest_User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type BackendUsersImportResponse_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Line of the file
+	Line    uint64 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Email   string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Code    int32  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BackendUsersImportResponse_Error) Reset() {
+	*x = BackendUsersImportResponse_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendUsersImportResponse_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendUsersImportResponse_Error) ProtoMessage() {}
+
+func (x *BackendUsersImportResponse_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendUsersImportResponse_Error.ProtoReflect.Descriptor instead.
+func (*BackendUsersImportResponse_Error) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *BackendUsersImportResponse_Error) GetLine() uint64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *BackendUsersImportResponse_Error) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BackendUsersImportResponse_Error) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BackendUsersImportResponse_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BackendRoleListResponse_Role struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *BackendRoleListResponse_Role) Reset() {
+	*x = BackendRoleListResponse_Role{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRoleListResponse_Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRoleListResponse_Role) ProtoMessage() {}
+
+func (x *BackendRoleListResponse_Role) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRoleListResponse_Role.ProtoReflect.Descriptor instead.
+func (*BackendRoleListResponse_Role) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *BackendRoleListResponse_Role) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendRoleListResponse_Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// All conditions are combined with AND. Empty fields are not applied.
+// Time range includes its start and excludes its end.
+type BackendAuditListRequest_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId  uint64                 `protobuf:"varint,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TargetId uint64                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Actions  []string               `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	From     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *BackendAuditListRequest_Filter) Reset() {
+	*x = BackendAuditListRequest_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendAuditListRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendAuditListRequest_Filter) ProtoMessage() {}
+
+func (x *BackendAuditListRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendAuditListRequest_Filter.ProtoReflect.Descriptor instead.
+func (*BackendAuditListRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *BackendAuditListRequest_Filter) GetActorId() uint64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *BackendAuditListRequest_Filter) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *BackendAuditListRequest_Filter) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *BackendAuditListRequest_Filter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BackendAuditListRequest_Filter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type BackendAuditListResponse_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Zero actor means that the change was made by the service itself
+	ActorId    uint64 `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorEmail string `protobuf:"bytes,3,opt,name=actor_email,json=actorEmail,proto3" json:"actor_email,omitempty"`
+	Action     string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	TargetId   uint64 `protobuf:"varint,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Values of passwords are redacted
+	Changes   map[string]*BackendAuditListResponse_FieldChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RequestId string                                           `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp                           `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *BackendAuditListResponse_Event) Reset() {
+	*x = BackendAuditListResponse_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendAuditListResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendAuditListResponse_Event) ProtoMessage() {}
+
+func (x *BackendAuditListResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendAuditListResponse_Event.ProtoReflect.Descriptor instead.
+func (*BackendAuditListResponse_Event) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *BackendAuditListResponse_Event) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackendAuditListResponse_Event) GetActorId() uint64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *BackendAuditListResponse_Event) GetActorEmail() string {
+	if x != nil {
+		return x.ActorEmail
+	}
+	return ""
+}
+
+func (x *BackendAuditListResponse_Event) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BackendAuditListResponse_Event) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *BackendAuditListResponse_Event) GetChanges() map[string]*BackendAuditListResponse_FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *BackendAuditListResponse_Event) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BackendAuditListResponse_Event) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BackendAuditListResponse_FieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Old string `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New string `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+}
+
+func (x *BackendAuditListResponse_FieldChange) Reset() {
+	*x = BackendAuditListResponse_FieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_backend_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendAuditListResponse_FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendAuditListResponse_FieldChange) ProtoMessage() {}
+
+func (x *BackendAuditListResponse_FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_backend_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendAuditListResponse_FieldChange.ProtoReflect.Descriptor instead.
+func (*BackendAuditListResponse_FieldChange) Descriptor() ([]byte, []int) {
+	return file_api_backend_proto_rawDescGZIP(), []int{37, 1}
+}
+
+func (x *BackendAuditListResponse_FieldChange) GetOld() string {
+	if x != nil {
+		return x.Old
+	}
+	return ""
+}
+
+func (x *BackendAuditListResponse_FieldChange) GetNew() string {
+	if x != nil {
+		return x.New
+	}
+	return ""
+}
+
+var File_api_backend_proto protoreflect.FileDescriptor
 
 var file_api_backend_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x16, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c,
 	0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x74, 0x0a, 0x18, 0x42, 0x61, 0x63,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x13,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x7d, 0x0a, 0x14, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x49, 0x6e, 0x22, 0x3c, 0x0a, 0x15, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x49, 0x6e, 0x22, 0x3b, 0x0a, 0x14, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x17, 0x0a, 0x15, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x0a, 0x18, 0x42, 0x61, 0x63,
 	0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e,
@@ -846,7 +3057,7 @@ var file_api_backend_proto_rawDesc = []byte{
 	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
 	0x2b, 0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa5, 0x02, 0x0a,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbf, 0x05, 0x0a,
 	0x16, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x5f, 0x70,
 	0x65, 0x72, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
@@ -858,53 +3069,134 @@ var file_api_backend_proto_rawDesc = []byte{
 	0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73,
 	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f,
 	0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x02, 0x52, 0x05, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x1a, 0x44, 0x0a, 0x0c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e,
-	0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x0f, 0x0a, 0x0d,
+	0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x52, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65,
+	0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x03, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x1a, 0x44,
+	0x0a, 0x0c, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x1a, 0x85, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x13,
+	0x0a, 0x05, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x69,
+	0x64, 0x54, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x0f, 0x0a, 0x0d,
 	0x5f, 0x72, 0x65, 0x63, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a,
 	0x09, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x22, 0xbb, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x34, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d,
-	0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x54, 0x0a, 0x04,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
-	0x6c, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c, 0x64,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6f, 0x6c, 0x64, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x42,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b,
-	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x94,
+	0x03, 0x0a, 0x17, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x1a, 0xa9, 0x01, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd1, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x6c, 0x64, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x19, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x8d, 0x02, 0x0a, 0x17, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x34, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1b,
+	0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x15, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x22, 0x1b, 0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a,
-	0x15, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
-	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x72,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xbb, 0x01,
+	0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x19, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x17, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x1a, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01,
 	0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64,
 	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
 	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12,
@@ -912,56 +3204,317 @@ var file_api_backend_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
 	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x32, 0xb6, 0x05,
-	0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x73, 0x0a, 0x0a, 0x55, 0x73, 0x65,
-	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64,
-	0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
-	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a,
-	0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
-	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e,
+	0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x22, 0x57, 0x0a, 0x17, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x35, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65,
+	0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0x68, 0x0a, 0x1a, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xf0, 0x03, 0x0a, 0x19, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e,
 	0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x08, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65,
-	0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65,
-	0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x0a, 0x55, 0x73, 0x65,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64,
+	0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6f, 0x7a, 0x6f,
+	0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x88, 0x01, 0x0a, 0x07, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x64, 0x0a,
+	0x0c, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76,
+	0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x6f, 0x6e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x1a, 0x74, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x31, 0x0a, 0x0f, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04,
+	0x46, 0x41, 0x49, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x22, 0xe4, 0x02, 0x0a,
+	0x1a, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x50, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65,
+	0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x1a, 0x5f, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x2e, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f,
+	0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f,
+	0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x18, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x17, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76,
+	0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x1a, 0x2a, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e,
+	0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1b,
+	0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x18, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x97, 0x03, 0x0a, 0x17, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x50, 0x65, 0x72,
+	0x50, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x07, 0x70, 0x61, 0x67,
+	0x65, 0x4e, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64,
 	0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48,
+	0x02, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x1a, 0xb6, 0x01, 0x0a,
+	0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x02, 0x74, 0x6f, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x63, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xb6,
+	0x05, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68,
+	0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68,
+	0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x1a, 0xbb, 0x03, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x5d, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c,
+	0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x78, 0x0a, 0x0c, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x52, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x31, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x32, 0x81, 0x11, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x12, 0x64, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
 	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73,
-	0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x6f,
+	0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x07, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x12, 0x2d, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76,
+	0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12,
+	0x2c, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d,
+	0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68,
+	0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73,
+	0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e,
+	0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x12, 0x2d,
+	0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e,
+	0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68,
+	0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6d, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73,
+	0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x6f,
 	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
 	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
 	0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e,
 	0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x12,
-	0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d,
+	0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65,
+	0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64,
+	0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76,
+	0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76,
+	0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x08, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x12, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65,
+	0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65,
+	0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x78, 0x0a,
+	0x0b, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d,
 	0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x78, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65,
+	0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x12, 0x76, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65,
+	0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76,
+	0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x09, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x75, 0x72, 0x67, 0x65, 0x12, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65,
+	0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64,
+	0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x0a, 0x52,
+	0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6d, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x73, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e,
+	0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68,
+	0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f,
+	0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d,
 	0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
-	0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2f,
-	0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x31, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
-	0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x6f, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c,
+	0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x09, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65,
+	0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64,
+	0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x76,
+	0x6c, 0x64, 0x65, 0x6d, 0x2f, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x31, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -976,43 +3529,126 @@ func file_api_backend_proto_rawDescGZIP() []byte {
 	return file_api_backend_proto_rawDescData
 }
 
-var file_api_backend_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_backend_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_backend_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_api_backend_proto_goTypes = []interface{}{
-	(*BackendUserCreateRequest)(nil),            // 0: ozon.dev.vldem.hw2.api.BackendUserCreateRequest
-	(*BackendUserCreateResponse)(nil),           // 1: ozon.dev.vldem.hw2.api.BackendUserCreateResponse
-	(*BackendUserListRequest)(nil),              // 2: ozon.dev.vldem.hw2.api.BackendUserListRequest
-	(*BackendUserListResponse)(nil),             // 3: ozon.dev.vldem.hw2.api.BackendUserListResponse
-	(*BackendUserUpdateRequest)(nil),            // 4: ozon.dev.vldem.hw2.api.BackendUserUpdateRequest
-	(*BackendUserUpdateResponse)(nil),           // 5: ozon.dev.vldem.hw2.api.BackendUserUpdateResponse
-	(*BackendUserDeleteRequest)(nil),            // 6: ozon.dev.vldem.hw2.api.BackendUserDeleteRequest
-	(*BackendUserDeleteResponse)(nil),           // 7: ozon.dev.vldem.hw2.api.BackendUserDeleteResponse
-	(*BackendUserGetRequest)(nil),               // 8: ozon.dev.vldem.hw2.api.BackendUserGetRequest
-	(*BackendUserGetResponse)(nil),              // 9: ozon.dev.vldem.hw2.api.BackendUserGetResponse
-	(*BackendUsersAddRequest)(nil),              // 10: ozon.dev.vldem.hw2.api.BackendUsersAddRequest
-	(*BackendUsersAddResponse)(nil),             // 11: ozon.dev.vldem.hw2.api.BackendUsersAddResponse
-	(*BackendUserListRequest_SortingOrder)(nil), // 12: ozon.dev.vldem.hw2.api.BackendUserListRequest.SortingOrder
-	(*BackendUserListResponse_User)(nil),        // 13: ozon.dev.vldem.hw2.api.BackendUserListResponse.User
+	(BackendUsersImportRequest_DuplicatePolicy)(0), // 0: ozon.dev.vldem.hw2.api.BackendUsersImportRequest.DuplicatePolicy
+	(*BackendLoginRequest)(nil),                    // 1: ozon.dev.vldem.hw2.api.BackendLoginRequest
+	(*BackendLoginResponse)(nil),                   // 2: ozon.dev.vldem.hw2.api.BackendLoginResponse
+	(*BackendRefreshRequest)(nil),                  // 3: ozon.dev.vldem.hw2.api.BackendRefreshRequest
+	(*BackendRefreshResponse)(nil),                 // 4: ozon.dev.vldem.hw2.api.BackendRefreshResponse
+	(*BackendLogoutRequest)(nil),                   // 5: ozon.dev.vldem.hw2.api.BackendLogoutRequest
+	(*BackendLogoutResponse)(nil),                  // 6: ozon.dev.vldem.hw2.api.BackendLogoutResponse
+	(*BackendUserCreateRequest)(nil),               // 7: ozon.dev.vldem.hw2.api.BackendUserCreateRequest
+	(*BackendUserCreateResponse)(nil),              // 8: ozon.dev.vldem.hw2.api.BackendUserCreateResponse
+	(*BackendUserListRequest)(nil),                 // 9: ozon.dev.vldem.hw2.api.BackendUserListRequest
+	(*BackendUserListResponse)(nil),                // 10: ozon.dev.vldem.hw2.api.BackendUserListResponse
+	(*BackendUserUpdateRequest)(nil),               // 11: ozon.dev.vldem.hw2.api.BackendUserUpdateRequest
+	(*BackendUserUpdateResponse)(nil),              // 12: ozon.dev.vldem.hw2.api.BackendUserUpdateResponse
+	(*BackendUserPatchRequest)(nil),                // 13: ozon.dev.vldem.hw2.api.BackendUserPatchRequest
+	(*BackendUserPatchResponse)(nil),               // 14: ozon.dev.vldem.hw2.api.BackendUserPatchResponse
+	(*BackendUserDeleteRequest)(nil),               // 15: ozon.dev.vldem.hw2.api.BackendUserDeleteRequest
+	(*BackendUserDeleteResponse)(nil),              // 16: ozon.dev.vldem.hw2.api.BackendUserDeleteResponse
+	(*BackendUserGetRequest)(nil),                  // 17: ozon.dev.vldem.hw2.api.BackendUserGetRequest
+	(*BackendUserGetResponse)(nil),                 // 18: ozon.dev.vldem.hw2.api.BackendUserGetResponse
+	(*BackendUserRestoreRequest)(nil),              // 19: ozon.dev.vldem.hw2.api.BackendUserRestoreRequest
+	(*BackendUserRestoreResponse)(nil),             // 20: ozon.dev.vldem.hw2.api.BackendUserRestoreResponse
+	(*BackendUserPurgeRequest)(nil),                // 21: ozon.dev.vldem.hw2.api.BackendUserPurgeRequest
+	(*BackendUserPurgeResponse)(nil),               // 22: ozon.dev.vldem.hw2.api.BackendUserPurgeResponse
+	(*BackendUsersAddRequest)(nil),                 // 23: ozon.dev.vldem.hw2.api.BackendUsersAddRequest
+	(*BackendUsersAddResponse)(nil),                // 24: ozon.dev.vldem.hw2.api.BackendUsersAddResponse
+	(*BackendUsersExportRequest)(nil),              // 25: ozon.dev.vldem.hw2.api.BackendUsersExportRequest
+	(*BackendUsersExportResponse)(nil),             // 26: ozon.dev.vldem.hw2.api.BackendUsersExportResponse
+	(*BackendUsersImportRequest)(nil),              // 27: ozon.dev.vldem.hw2.api.BackendUsersImportRequest
+	(*BackendUsersImportResponse)(nil),             // 28: ozon.dev.vldem.hw2.api.BackendUsersImportResponse
+	(*BackendRoleCreateRequest)(nil),               // 29: ozon.dev.vldem.hw2.api.BackendRoleCreateRequest
+	(*BackendRoleCreateResponse)(nil),              // 30: ozon.dev.vldem.hw2.api.BackendRoleCreateResponse
+	(*BackendRoleListRequest)(nil),                 // 31: ozon.dev.vldem.hw2.api.BackendRoleListRequest
+	(*BackendRoleListResponse)(nil),                // 32: ozon.dev.vldem.hw2.api.BackendRoleListResponse
+	(*BackendRoleUpdateRequest)(nil),               // 33: ozon.dev.vldem.hw2.api.BackendRoleUpdateRequest
+	(*BackendRoleUpdateResponse)(nil),              // 34: ozon.dev.vldem.hw2.api.BackendRoleUpdateResponse
+	(*BackendRoleDeleteRequest)(nil),               // 35: ozon.dev.vldem.hw2.api.BackendRoleDeleteRequest
+	(*BackendRoleDeleteResponse)(nil),              // 36: ozon.dev.vldem.hw2.api.BackendRoleDeleteResponse
+	(*BackendAuditListRequest)(nil),                // 37: ozon.dev.vldem.hw2.api.BackendAuditListRequest
+	(*BackendAuditListResponse)(nil),               // 38: ozon.dev.vldem.hw2.api.BackendAuditListResponse
+	(*BackendUserListRequest_SortingOrder)(nil),    // 39: ozon.dev.vldem.hw2.api.BackendUserListRequest.SortingOrder
+	(*BackendUserListRequest_Filter)(nil),          // 40: ozon.dev.vldem.hw2.api.BackendUserListRequest.Filter
+	(*BackendUserListResponse_User)(nil),           // 41: ozon.dev.vldem.hw2.api.BackendUserListResponse.User
+	(*BackendUsersImportRequest_Options)(nil),      // 42: ozon.dev.vldem.hw2.api.BackendUsersImportRequest.Options
+	(*BackendUsersImportRequest_User)(nil),         // 43: ozon.dev.vldem.hw2.api.BackendUsersImportRequest.User
+	(*BackendUsersImportResponse_Error)(nil),       // 44: ozon.dev.vldem.hw2.api.BackendUsersImportResponse.Error
+	(*BackendRoleListResponse_Role)(nil),           // 45: ozon.dev.vldem.hw2.api.BackendRoleListResponse.Role
+	(*BackendAuditListRequest_Filter)(nil),         // 46: ozon.dev.vldem.hw2.api.BackendAuditListRequest.Filter
+	(*BackendAuditListResponse_Event)(nil),         // 47: ozon.dev.vldem.hw2.api.BackendAuditListResponse.Event
+	(*BackendAuditListResponse_FieldChange)(nil),   // 48: ozon.dev.vldem.hw2.api.BackendAuditListResponse.FieldChange
+	nil,                           // 49: ozon.dev.vldem.hw2.api.BackendAuditListResponse.Event.ChangesEntry
+	(*fieldmaskpb.FieldMask)(nil), // 50: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil), // 51: google.protobuf.Timestamp
 }
 var file_api_backend_proto_depIdxs = []int32{
-	12, // 0: ozon.dev.vldem.hw2.api.BackendUserListRequest.order:type_name -> ozon.dev.vldem.hw2.api.BackendUserListRequest.SortingOrder
-	13, // 1: ozon.dev.vldem.hw2.api.BackendUserListResponse.users:type_name -> ozon.dev.vldem.hw2.api.BackendUserListResponse.User
-	0,  // 2: ozon.dev.vldem.hw2.api.Backend.UserCreate:input_type -> ozon.dev.vldem.hw2.api.BackendUserCreateRequest
-	8,  // 3: ozon.dev.vldem.hw2.api.Backend.UserGet:input_type -> ozon.dev.vldem.hw2.api.BackendUserGetRequest
-	2,  // 4: ozon.dev.vldem.hw2.api.Backend.UserList:input_type -> ozon.dev.vldem.hw2.api.BackendUserListRequest
-	4,  // 5: ozon.dev.vldem.hw2.api.Backend.UserUpdate:input_type -> ozon.dev.vldem.hw2.api.BackendUserUpdateRequest
-	6,  // 6: ozon.dev.vldem.hw2.api.Backend.UserDelete:input_type -> ozon.dev.vldem.hw2.api.BackendUserDeleteRequest
-	10, // 7: ozon.dev.vldem.hw2.api.Backend.UsersAdd:input_type -> ozon.dev.vldem.hw2.api.BackendUsersAddRequest
-	1,  // 8: ozon.dev.vldem.hw2.api.Backend.UserCreate:output_type -> ozon.dev.vldem.hw2.api.BackendUserCreateResponse
-	9,  // 9: ozon.dev.vldem.hw2.api.Backend.UserGet:output_type -> ozon.dev.vldem.hw2.api.BackendUserGetResponse
-	3,  // 10: ozon.dev.vldem.hw2.api.Backend.UserList:output_type -> ozon.dev.vldem.hw2.api.BackendUserListResponse
-	5,  // 11: ozon.dev.vldem.hw2.api.Backend.UserUpdate:output_type -> ozon.dev.vldem.hw2.api.BackendUserUpdateResponse
-	7,  // 12: ozon.dev.vldem.hw2.api.Backend.UserDelete:output_type -> ozon.dev.vldem.hw2.api.BackendUserDeleteResponse
-	11, // 13: ozon.dev.vldem.hw2.api.Backend.UsersAdd:output_type -> ozon.dev.vldem.hw2.api.BackendUsersAddResponse
-	8,  // [8:14] is the sub-list for method output_type
-	2,  // [2:8] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	39, // 0: ozon.dev.vldem.hw2.api.BackendUserListRequest.order:type_name -> ozon.dev.vldem.hw2.api.BackendUserListRequest.SortingOrder
+	40, // 1: ozon.dev.vldem.hw2.api.BackendUserListRequest.filter:type_name -> ozon.dev.vldem.hw2.api.BackendUserListRequest.Filter
+	41, // 2: ozon.dev.vldem.hw2.api.BackendUserListResponse.users:type_name -> ozon.dev.vldem.hw2.api.BackendUserListResponse.User
+	50, // 3: ozon.dev.vldem.hw2.api.BackendUserPatchRequest.update_mask:type_name -> google.protobuf.FieldMask
+	51, // 4: ozon.dev.vldem.hw2.api.BackendUserGetResponse.deleted_at:type_name -> google.protobuf.Timestamp
+	40, // 5: ozon.dev.vldem.hw2.api.BackendUsersExportRequest.filter:type_name -> ozon.dev.vldem.hw2.api.BackendUserListRequest.Filter
+	41, // 6: ozon.dev.vldem.hw2.api.BackendUsersExportResponse.users:type_name -> ozon.dev.vldem.hw2.api.BackendUserListResponse.User
+	42, // 7: ozon.dev.vldem.hw2.api.BackendUsersImportRequest.options:type_name -> ozon.dev.vldem.hw2.api.BackendUsersImportRequest.Options
+	43, // 8: ozon.dev.vldem.hw2.api.BackendUsersImportRequest.user:type_name -> ozon.dev.vldem.hw2.api.BackendUsersImportRequest.User
+	44, // 9: ozon.dev.vldem.hw2.api.BackendUsersImportResponse.errors:type_name -> ozon.dev.vldem.hw2.api.BackendUsersImportResponse.Error
+	45, // 10: ozon.dev.vldem.hw2.api.BackendRoleListResponse.roles:type_name -> ozon.dev.vldem.hw2.api.BackendRoleListResponse.Role
+	46, // 11: ozon.dev.vldem.hw2.api.BackendAuditListRequest.filter:type_name -> ozon.dev.vldem.hw2.api.BackendAuditListRequest.Filter
+	47, // 12: ozon.dev.vldem.hw2.api.BackendAuditListResponse.events:type_name -> ozon.dev.vldem.hw2.api.BackendAuditListResponse.Event
+	51, // 13: ozon.dev.vldem.hw2.api.BackendUserListResponse.User.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 14: ozon.dev.vldem.hw2.api.BackendUsersImportRequest.Options.on_duplicate:type_name -> ozon.dev.vldem.hw2.api.BackendUsersImportRequest.DuplicatePolicy
+	51, // 15: ozon.dev.vldem.hw2.api.BackendAuditListRequest.Filter.from:type_name -> google.protobuf.Timestamp
+	51, // 16: ozon.dev.vldem.hw2.api.BackendAuditListRequest.Filter.to:type_name -> google.protobuf.Timestamp
+	49, // 17: ozon.dev.vldem.hw2.api.BackendAuditListResponse.Event.changes:type_name -> ozon.dev.vldem.hw2.api.BackendAuditListResponse.Event.ChangesEntry
+	51, // 18: ozon.dev.vldem.hw2.api.BackendAuditListResponse.Event.created_at:type_name -> google.protobuf.Timestamp
+	48, // 19: ozon.dev.vldem.hw2.api.BackendAuditListResponse.Event.ChangesEntry.value:type_name -> ozon.dev.vldem.hw2.api.BackendAuditListResponse.FieldChange
+	1,  // 20: ozon.dev.vldem.hw2.api.Backend.Login:input_type -> ozon.dev.vldem.hw2.api.BackendLoginRequest
+	3,  // 21: ozon.dev.vldem.hw2.api.Backend.Refresh:input_type -> ozon.dev.vldem.hw2.api.BackendRefreshRequest
+	5,  // 22: ozon.dev.vldem.hw2.api.Backend.Logout:input_type -> ozon.dev.vldem.hw2.api.BackendLogoutRequest
+	7,  // 23: ozon.dev.vldem.hw2.api.Backend.UserCreate:input_type -> ozon.dev.vldem.hw2.api.BackendUserCreateRequest
+	17, // 24: ozon.dev.vldem.hw2.api.Backend.UserGet:input_type -> ozon.dev.vldem.hw2.api.BackendUserGetRequest
+	9,  // 25: ozon.dev.vldem.hw2.api.Backend.UserList:input_type -> ozon.dev.vldem.hw2.api.BackendUserListRequest
+	11, // 26: ozon.dev.vldem.hw2.api.Backend.UserUpdate:input_type -> ozon.dev.vldem.hw2.api.BackendUserUpdateRequest
+	13, // 27: ozon.dev.vldem.hw2.api.Backend.UserPatch:input_type -> ozon.dev.vldem.hw2.api.BackendUserPatchRequest
+	15, // 28: ozon.dev.vldem.hw2.api.Backend.UserDelete:input_type -> ozon.dev.vldem.hw2.api.BackendUserDeleteRequest
+	23, // 29: ozon.dev.vldem.hw2.api.Backend.UsersAdd:input_type -> ozon.dev.vldem.hw2.api.BackendUsersAddRequest
+	25, // 30: ozon.dev.vldem.hw2.api.Backend.UsersExport:input_type -> ozon.dev.vldem.hw2.api.BackendUsersExportRequest
+	27, // 31: ozon.dev.vldem.hw2.api.Backend.UsersImport:input_type -> ozon.dev.vldem.hw2.api.BackendUsersImportRequest
+	19, // 32: ozon.dev.vldem.hw2.api.Backend.UserRestore:input_type -> ozon.dev.vldem.hw2.api.BackendUserRestoreRequest
+	21, // 33: ozon.dev.vldem.hw2.api.Backend.UserPurge:input_type -> ozon.dev.vldem.hw2.api.BackendUserPurgeRequest
+	29, // 34: ozon.dev.vldem.hw2.api.Backend.RoleCreate:input_type -> ozon.dev.vldem.hw2.api.BackendRoleCreateRequest
+	31, // 35: ozon.dev.vldem.hw2.api.Backend.RoleList:input_type -> ozon.dev.vldem.hw2.api.BackendRoleListRequest
+	33, // 36: ozon.dev.vldem.hw2.api.Backend.RoleUpdate:input_type -> ozon.dev.vldem.hw2.api.BackendRoleUpdateRequest
+	35, // 37: ozon.dev.vldem.hw2.api.Backend.RoleDelete:input_type -> ozon.dev.vldem.hw2.api.BackendRoleDeleteRequest
+	37, // 38: ozon.dev.vldem.hw2.api.Backend.AuditList:input_type -> ozon.dev.vldem.hw2.api.BackendAuditListRequest
+	2,  // 39: ozon.dev.vldem.hw2.api.Backend.Login:output_type -> ozon.dev.vldem.hw2.api.BackendLoginResponse
+	4,  // 40: ozon.dev.vldem.hw2.api.Backend.Refresh:output_type -> ozon.dev.vldem.hw2.api.BackendRefreshResponse
+	6,  // 41: ozon.dev.vldem.hw2.api.Backend.Logout:output_type -> ozon.dev.vldem.hw2.api.BackendLogoutResponse
+	8,  // 42: ozon.dev.vldem.hw2.api.Backend.UserCreate:output_type -> ozon.dev.vldem.hw2.api.BackendUserCreateResponse
+	18, // 43: ozon.dev.vldem.hw2.api.Backend.UserGet:output_type -> ozon.dev.vldem.hw2.api.BackendUserGetResponse
+	10, // 44: ozon.dev.vldem.hw2.api.Backend.UserList:output_type -> ozon.dev.vldem.hw2.api.BackendUserListResponse
+	12, // 45: ozon.dev.vldem.hw2.api.Backend.UserUpdate:output_type -> ozon.dev.vldem.hw2.api.BackendUserUpdateResponse
+	14, // 46: ozon.dev.vldem.hw2.api.Backend.UserPatch:output_type -> ozon.dev.vldem.hw2.api.BackendUserPatchResponse
+	16, // 47: ozon.dev.vldem.hw2.api.Backend.UserDelete:output_type -> ozon.dev.vldem.hw2.api.BackendUserDeleteResponse
+	24, // 48: ozon.dev.vldem.hw2.api.Backend.UsersAdd:output_type -> ozon.dev.vldem.hw2.api.BackendUsersAddResponse
+	26, // 49: ozon.dev.vldem.hw2.api.Backend.UsersExport:output_type -> ozon.dev.vldem.hw2.api.BackendUsersExportResponse
+	28, // 50: ozon.dev.vldem.hw2.api.Backend.UsersImport:output_type -> ozon.dev.vldem.hw2.api.BackendUsersImportResponse
+	20, // 51: ozon.dev.vldem.hw2.api.Backend.UserRestore:output_type -> ozon.dev.vldem.hw2.api.BackendUserRestoreResponse
+	22, // 52: ozon.dev.vldem.hw2.api.Backend.UserPurge:output_type -> ozon.dev.vldem.hw2.api.BackendUserPurgeResponse
+	30, // 53: ozon.dev.vldem.hw2.api.Backend.RoleCreate:output_type -> ozon.dev.vldem.hw2.api.BackendRoleCreateResponse
+	32, // 54: ozon.dev.vldem.hw2.api.Backend.RoleList:output_type -> ozon.dev.vldem.hw2.api.BackendRoleListResponse
+	34, // 55: ozon.dev.vldem.hw2.api.Backend.RoleUpdate:output_type -> ozon.dev.vldem.hw2.api.BackendRoleUpdateResponse
+	36, // 56: ozon.dev.vldem.hw2.api.Backend.RoleDelete:output_type -> ozon.dev.vldem.hw2.api.BackendRoleDeleteResponse
+	38, // 57: ozon.dev.vldem.hw2.api.Backend.AuditList:output_type -> ozon.dev.vldem.hw2.api.BackendAuditListResponse
+	39, // [39:58] is the sub-list for method output_type
+	20, // [20:39] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_api_backend_proto_init() }
@@ -1022,6 +3658,78 @@ func file_api_backend_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_api_backend_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRefreshResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendLogoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendLogoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackendUserCreateRequest); i {
 			case 0:
 				return &v.state
@@ -1033,8 +3741,80 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendUserCreateResponse); i {
+		file_api_backend_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserPatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserPatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1045,8 +3825,8 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendUserListRequest); i {
+		file_api_backend_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1057,8 +3837,8 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendUserListResponse); i {
+		file_api_backend_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1069,8 +3849,8 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendUserUpdateRequest); i {
+		file_api_backend_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1081,8 +3861,8 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendUserUpdateResponse); i {
+		file_api_backend_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserGetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1093,8 +3873,8 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendUserDeleteRequest); i {
+		file_api_backend_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserRestoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1105,8 +3885,8 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendUserDeleteResponse); i {
+		file_api_backend_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserRestoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1117,8 +3897,8 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendUserGetRequest); i {
+		file_api_backend_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserPurgeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1129,8 +3909,8 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackendUserGetResponse); i {
+		file_api_backend_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserPurgeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1141,7 +3921,7 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_api_backend_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackendUsersAddRequest); i {
 			case 0:
 				return &v.state
@@ -1153,7 +3933,7 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_api_backend_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackendUsersAddResponse); i {
 			case 0:
 				return &v.state
@@ -1165,7 +3945,175 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_api_backend_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUsersExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUsersExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUsersImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUsersImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRoleCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRoleCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRoleListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRoleListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRoleUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRoleUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRoleDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRoleDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendAuditListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendAuditListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackendUserListRequest_SortingOrder); i {
 			case 0:
 				return &v.state
@@ -1177,7 +4125,19 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
-		file_api_backend_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_api_backend_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUserListRequest_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackendUserListResponse_User); i {
 			case 0:
 				return &v.state
@@ -1189,20 +4149,108 @@ func file_api_backend_proto_init() {
 				return nil
 			}
 		}
+		file_api_backend_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUsersImportRequest_Options); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUsersImportRequest_User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendUsersImportResponse_Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRoleListResponse_Role); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendAuditListRequest_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendAuditListResponse_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_backend_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendAuditListResponse_FieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_backend_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_api_backend_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_api_backend_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_api_backend_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_api_backend_proto_msgTypes[36].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_backend_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      1,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_backend_proto_goTypes,
 		DependencyIndexes: file_api_backend_proto_depIdxs,
+		EnumInfos:         file_api_backend_proto_enumTypes,
 		MessageInfos:      file_api_backend_proto_msgTypes,
 	}.Build()
 	File_api_backend_proto = out.File
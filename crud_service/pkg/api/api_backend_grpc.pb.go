@@ -22,12 +22,25 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BackendClient interface {
+	Login(ctx context.Context, in *BackendLoginRequest, opts ...grpc.CallOption) (*BackendLoginResponse, error)
+	Refresh(ctx context.Context, in *BackendRefreshRequest, opts ...grpc.CallOption) (*BackendRefreshResponse, error)
+	Logout(ctx context.Context, in *BackendLogoutRequest, opts ...grpc.CallOption) (*BackendLogoutResponse, error)
 	UserCreate(ctx context.Context, in *BackendUserCreateRequest, opts ...grpc.CallOption) (*BackendUserCreateResponse, error)
 	UserGet(ctx context.Context, in *BackendUserGetRequest, opts ...grpc.CallOption) (*BackendUserGetResponse, error)
 	UserList(ctx context.Context, in *BackendUserListRequest, opts ...grpc.CallOption) (*BackendUserListResponse, error)
 	UserUpdate(ctx context.Context, in *BackendUserUpdateRequest, opts ...grpc.CallOption) (*BackendUserUpdateResponse, error)
+	UserPatch(ctx context.Context, in *BackendUserPatchRequest, opts ...grpc.CallOption) (*BackendUserPatchResponse, error)
 	UserDelete(ctx context.Context, in *BackendUserDeleteRequest, opts ...grpc.CallOption) (*BackendUserDeleteResponse, error)
 	UsersAdd(ctx context.Context, opts ...grpc.CallOption) (Backend_UsersAddClient, error)
+	UsersExport(ctx context.Context, in *BackendUsersExportRequest, opts ...grpc.CallOption) (Backend_UsersExportClient, error)
+	UsersImport(ctx context.Context, opts ...grpc.CallOption) (Backend_UsersImportClient, error)
+	UserRestore(ctx context.Context, in *BackendUserRestoreRequest, opts ...grpc.CallOption) (*BackendUserRestoreResponse, error)
+	UserPurge(ctx context.Context, in *BackendUserPurgeRequest, opts ...grpc.CallOption) (*BackendUserPurgeResponse, error)
+	RoleCreate(ctx context.Context, in *BackendRoleCreateRequest, opts ...grpc.CallOption) (*BackendRoleCreateResponse, error)
+	RoleList(ctx context.Context, in *BackendRoleListRequest, opts ...grpc.CallOption) (*BackendRoleListResponse, error)
+	RoleUpdate(ctx context.Context, in *BackendRoleUpdateRequest, opts ...grpc.CallOption) (*BackendRoleUpdateResponse, error)
+	RoleDelete(ctx context.Context, in *BackendRoleDeleteRequest, opts ...grpc.CallOption) (*BackendRoleDeleteResponse, error)
+	AuditList(ctx context.Context, in *BackendAuditListRequest, opts ...grpc.CallOption) (*BackendAuditListResponse, error)
 }
 
 type backendClient struct {
@@ -38,6 +51,33 @@ func NewBackendClient(cc grpc.ClientConnInterface) BackendClient {
 	return &backendClient{cc}
 }
 
+func (c *backendClient) Login(ctx context.Context, in *BackendLoginRequest, opts ...grpc.CallOption) (*BackendLoginResponse, error) {
+	out := new(BackendLoginResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) Refresh(ctx context.Context, in *BackendRefreshRequest, opts ...grpc.CallOption) (*BackendRefreshResponse, error) {
+	out := new(BackendRefreshResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/Refresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) Logout(ctx context.Context, in *BackendLogoutRequest, opts ...grpc.CallOption) (*BackendLogoutResponse, error) {
+	out := new(BackendLogoutResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *backendClient) UserCreate(ctx context.Context, in *BackendUserCreateRequest, opts ...grpc.CallOption) (*BackendUserCreateResponse, error) {
 	out := new(BackendUserCreateResponse)
 	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/UserCreate", in, out, opts...)
@@ -74,6 +114,15 @@ func (c *backendClient) UserUpdate(ctx context.Context, in *BackendUserUpdateReq
 	return out, nil
 }
 
+func (c *backendClient) UserPatch(ctx context.Context, in *BackendUserPatchRequest, opts ...grpc.CallOption) (*BackendUserPatchResponse, error) {
+	out := new(BackendUserPatchResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/UserPatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *backendClient) UserDelete(ctx context.Context, in *BackendUserDeleteRequest, opts ...grpc.CallOption) (*BackendUserDeleteResponse, error) {
 	out := new(BackendUserDeleteResponse)
 	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/UserDelete", in, out, opts...)
@@ -114,16 +163,158 @@ func (x *backendUsersAddClient) Recv() (*BackendUsersAddResponse, error) {
 	return m, nil
 }
 
+func (c *backendClient) UsersExport(ctx context.Context, in *BackendUsersExportRequest, opts ...grpc.CallOption) (Backend_UsersExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Backend_ServiceDesc.Streams[1], "/ozon.dev.vldem.hw2.api.Backend/UsersExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &backendUsersExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Backend_UsersExportClient interface {
+	Recv() (*BackendUsersExportResponse, error)
+	grpc.ClientStream
+}
+
+type backendUsersExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *backendUsersExportClient) Recv() (*BackendUsersExportResponse, error) {
+	m := new(BackendUsersExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *backendClient) UsersImport(ctx context.Context, opts ...grpc.CallOption) (Backend_UsersImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Backend_ServiceDesc.Streams[2], "/ozon.dev.vldem.hw2.api.Backend/UsersImport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &backendUsersImportClient{stream}
+	return x, nil
+}
+
+type Backend_UsersImportClient interface {
+	Send(*BackendUsersImportRequest) error
+	CloseAndRecv() (*BackendUsersImportResponse, error)
+	grpc.ClientStream
+}
+
+type backendUsersImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *backendUsersImportClient) Send(m *BackendUsersImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *backendUsersImportClient) CloseAndRecv() (*BackendUsersImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BackendUsersImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *backendClient) UserRestore(ctx context.Context, in *BackendUserRestoreRequest, opts ...grpc.CallOption) (*BackendUserRestoreResponse, error) {
+	out := new(BackendUserRestoreResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/UserRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) UserPurge(ctx context.Context, in *BackendUserPurgeRequest, opts ...grpc.CallOption) (*BackendUserPurgeResponse, error) {
+	out := new(BackendUserPurgeResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/UserPurge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) RoleCreate(ctx context.Context, in *BackendRoleCreateRequest, opts ...grpc.CallOption) (*BackendRoleCreateResponse, error) {
+	out := new(BackendRoleCreateResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/RoleCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) RoleList(ctx context.Context, in *BackendRoleListRequest, opts ...grpc.CallOption) (*BackendRoleListResponse, error) {
+	out := new(BackendRoleListResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/RoleList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) RoleUpdate(ctx context.Context, in *BackendRoleUpdateRequest, opts ...grpc.CallOption) (*BackendRoleUpdateResponse, error) {
+	out := new(BackendRoleUpdateResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/RoleUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) RoleDelete(ctx context.Context, in *BackendRoleDeleteRequest, opts ...grpc.CallOption) (*BackendRoleDeleteResponse, error) {
+	out := new(BackendRoleDeleteResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/RoleDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backendClient) AuditList(ctx context.Context, in *BackendAuditListRequest, opts ...grpc.CallOption) (*BackendAuditListResponse, error) {
+	out := new(BackendAuditListResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Backend/AuditList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BackendServer is the server API for Backend service.
 // All implementations must embed UnimplementedBackendServer
 // for forward compatibility
 type BackendServer interface {
+	Login(context.Context, *BackendLoginRequest) (*BackendLoginResponse, error)
+	Refresh(context.Context, *BackendRefreshRequest) (*BackendRefreshResponse, error)
+	Logout(context.Context, *BackendLogoutRequest) (*BackendLogoutResponse, error)
 	UserCreate(context.Context, *BackendUserCreateRequest) (*BackendUserCreateResponse, error)
 	UserGet(context.Context, *BackendUserGetRequest) (*BackendUserGetResponse, error)
 	UserList(context.Context, *BackendUserListRequest) (*BackendUserListResponse, error)
 	UserUpdate(context.Context, *BackendUserUpdateRequest) (*BackendUserUpdateResponse, error)
+	UserPatch(context.Context, *BackendUserPatchRequest) (*BackendUserPatchResponse, error)
 	UserDelete(context.Context, *BackendUserDeleteRequest) (*BackendUserDeleteResponse, error)
 	UsersAdd(Backend_UsersAddServer) error
+	UsersExport(*BackendUsersExportRequest, Backend_UsersExportServer) error
+	UsersImport(Backend_UsersImportServer) error
+	UserRestore(context.Context, *BackendUserRestoreRequest) (*BackendUserRestoreResponse, error)
+	UserPurge(context.Context, *BackendUserPurgeRequest) (*BackendUserPurgeResponse, error)
+	RoleCreate(context.Context, *BackendRoleCreateRequest) (*BackendRoleCreateResponse, error)
+	RoleList(context.Context, *BackendRoleListRequest) (*BackendRoleListResponse, error)
+	RoleUpdate(context.Context, *BackendRoleUpdateRequest) (*BackendRoleUpdateResponse, error)
+	RoleDelete(context.Context, *BackendRoleDeleteRequest) (*BackendRoleDeleteResponse, error)
+	AuditList(context.Context, *BackendAuditListRequest) (*BackendAuditListResponse, error)
 	mustEmbedUnimplementedBackendServer()
 }
 
@@ -131,6 +322,15 @@ type BackendServer interface {
 type UnimplementedBackendServer struct {
 }
 
+func (UnimplementedBackendServer) Login(context.Context, *BackendLoginRequest) (*BackendLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedBackendServer) Refresh(context.Context, *BackendRefreshRequest) (*BackendRefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedBackendServer) Logout(context.Context, *BackendLogoutRequest) (*BackendLogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
 func (UnimplementedBackendServer) UserCreate(context.Context, *BackendUserCreateRequest) (*BackendUserCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserCreate not implemented")
 }
@@ -143,12 +343,42 @@ func (UnimplementedBackendServer) UserList(context.Context, *BackendUserListRequ
 func (UnimplementedBackendServer) UserUpdate(context.Context, *BackendUserUpdateRequest) (*BackendUserUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserUpdate not implemented")
 }
+func (UnimplementedBackendServer) UserPatch(context.Context, *BackendUserPatchRequest) (*BackendUserPatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPatch not implemented")
+}
 func (UnimplementedBackendServer) UserDelete(context.Context, *BackendUserDeleteRequest) (*BackendUserDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserDelete not implemented")
 }
 func (UnimplementedBackendServer) UsersAdd(Backend_UsersAddServer) error {
 	return status.Errorf(codes.Unimplemented, "method UsersAdd not implemented")
 }
+func (UnimplementedBackendServer) UsersExport(*BackendUsersExportRequest, Backend_UsersExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method UsersExport not implemented")
+}
+func (UnimplementedBackendServer) UsersImport(Backend_UsersImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method UsersImport not implemented")
+}
+func (UnimplementedBackendServer) UserRestore(context.Context, *BackendUserRestoreRequest) (*BackendUserRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRestore not implemented")
+}
+func (UnimplementedBackendServer) UserPurge(context.Context, *BackendUserPurgeRequest) (*BackendUserPurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPurge not implemented")
+}
+func (UnimplementedBackendServer) RoleCreate(context.Context, *BackendRoleCreateRequest) (*BackendRoleCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleCreate not implemented")
+}
+func (UnimplementedBackendServer) RoleList(context.Context, *BackendRoleListRequest) (*BackendRoleListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleList not implemented")
+}
+func (UnimplementedBackendServer) RoleUpdate(context.Context, *BackendRoleUpdateRequest) (*BackendRoleUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleUpdate not implemented")
+}
+func (UnimplementedBackendServer) RoleDelete(context.Context, *BackendRoleDeleteRequest) (*BackendRoleDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleDelete not implemented")
+}
+func (UnimplementedBackendServer) AuditList(context.Context, *BackendAuditListRequest) (*BackendAuditListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditList not implemented")
+}
 func (UnimplementedBackendServer) mustEmbedUnimplementedBackendServer() {}
 
 // UnsafeBackendServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +392,60 @@ func RegisterBackendServer(s grpc.ServiceRegistrar, srv BackendServer) {
 	s.RegisterService(&Backend_ServiceDesc, srv)
 }
 
+func _Backend_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).Login(ctx, req.(*BackendLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/Refresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).Refresh(ctx, req.(*BackendRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendLogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).Logout(ctx, req.(*BackendLogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Backend_UserCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BackendUserCreateRequest)
 	if err := dec(in); err != nil {
@@ -234,6 +518,24 @@ func _Backend_UserUpdate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Backend_UserPatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendUserPatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).UserPatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/UserPatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).UserPatch(ctx, req.(*BackendUserPatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Backend_UserDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BackendUserDeleteRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +580,179 @@ func (x *backendUsersAddServer) Recv() (*BackendUsersAddRequest, error) {
 	return m, nil
 }
 
+func _Backend_UsersExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackendUsersExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BackendServer).UsersExport(m, &backendUsersExportServer{stream})
+}
+
+type Backend_UsersExportServer interface {
+	Send(*BackendUsersExportResponse) error
+	grpc.ServerStream
+}
+
+type backendUsersExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *backendUsersExportServer) Send(m *BackendUsersExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Backend_UsersImport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BackendServer).UsersImport(&backendUsersImportServer{stream})
+}
+
+type Backend_UsersImportServer interface {
+	SendAndClose(*BackendUsersImportResponse) error
+	Recv() (*BackendUsersImportRequest, error)
+	grpc.ServerStream
+}
+
+type backendUsersImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *backendUsersImportServer) SendAndClose(m *BackendUsersImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *backendUsersImportServer) Recv() (*BackendUsersImportRequest, error) {
+	m := new(BackendUsersImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Backend_UserRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendUserRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).UserRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/UserRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).UserRestore(ctx, req.(*BackendUserRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_UserPurge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendUserPurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).UserPurge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/UserPurge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).UserPurge(ctx, req.(*BackendUserPurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_RoleCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendRoleCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).RoleCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/RoleCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).RoleCreate(ctx, req.(*BackendRoleCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_RoleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendRoleListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).RoleList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/RoleList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).RoleList(ctx, req.(*BackendRoleListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_RoleUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendRoleUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).RoleUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/RoleUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).RoleUpdate(ctx, req.(*BackendRoleUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_RoleDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendRoleDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).RoleDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/RoleDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).RoleDelete(ctx, req.(*BackendRoleDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backend_AuditList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackendAuditListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackendServer).AuditList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Backend/AuditList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackendServer).AuditList(ctx, req.(*BackendAuditListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Backend_ServiceDesc is the grpc.ServiceDesc for Backend service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -285,6 +760,18 @@ var Backend_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "ozon.dev.vldem.hw2.api.Backend",
 	HandlerType: (*BackendServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _Backend_Login_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _Backend_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Backend_Logout_Handler,
+		},
 		{
 			MethodName: "UserCreate",
 			Handler:    _Backend_UserCreate_Handler,
@@ -301,10 +788,42 @@ var Backend_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserUpdate",
 			Handler:    _Backend_UserUpdate_Handler,
 		},
+		{
+			MethodName: "UserPatch",
+			Handler:    _Backend_UserPatch_Handler,
+		},
 		{
 			MethodName: "UserDelete",
 			Handler:    _Backend_UserDelete_Handler,
 		},
+		{
+			MethodName: "UserRestore",
+			Handler:    _Backend_UserRestore_Handler,
+		},
+		{
+			MethodName: "UserPurge",
+			Handler:    _Backend_UserPurge_Handler,
+		},
+		{
+			MethodName: "RoleCreate",
+			Handler:    _Backend_RoleCreate_Handler,
+		},
+		{
+			MethodName: "RoleList",
+			Handler:    _Backend_RoleList_Handler,
+		},
+		{
+			MethodName: "RoleUpdate",
+			Handler:    _Backend_RoleUpdate_Handler,
+		},
+		{
+			MethodName: "RoleDelete",
+			Handler:    _Backend_RoleDelete_Handler,
+		},
+		{
+			MethodName: "AuditList",
+			Handler:    _Backend_AuditList_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -313,6 +832,16 @@ var Backend_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UsersExport",
+			Handler:       _Backend_UsersExport_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UsersImport",
+			Handler:       _Backend_UsersImport_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "api_backend.proto",
 }
@@ -22,12 +22,27 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AdminClient interface {
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 	UserCreate(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*UserCreateResponse, error)
 	UserGet(ctx context.Context, in *UserGetRequest, opts ...grpc.CallOption) (*UserGetResponse, error)
 	UserList(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error)
 	UsersAdd(ctx context.Context, in *UsersAddRequest, opts ...grpc.CallOption) (*UsersAddResponse, error)
+	// Files are passed through REST as they are by GET /v1/users/export and POST /v1/users/import,
+	// these routes are served by the gateway itself
+	UsersExport(ctx context.Context, in *UsersExportRequest, opts ...grpc.CallOption) (Admin_UsersExportClient, error)
+	UsersImport(ctx context.Context, opts ...grpc.CallOption) (Admin_UsersImportClient, error)
 	UserUpdate(ctx context.Context, in *UserUpdateRequest, opts ...grpc.CallOption) (*UserUpdateResponse, error)
+	UserPatch(ctx context.Context, in *UserPatchRequest, opts ...grpc.CallOption) (*UserPatchResponse, error)
 	UserDelete(ctx context.Context, in *UserDeleteRequest, opts ...grpc.CallOption) (*UserDeleteResponse, error)
+	UserRestore(ctx context.Context, in *UserRestoreRequest, opts ...grpc.CallOption) (*UserRestoreResponse, error)
+	UserPurge(ctx context.Context, in *UserPurgeRequest, opts ...grpc.CallOption) (*UserPurgeResponse, error)
+	RoleCreate(ctx context.Context, in *RoleCreateRequest, opts ...grpc.CallOption) (*RoleCreateResponse, error)
+	RoleList(ctx context.Context, in *RoleListRequest, opts ...grpc.CallOption) (*RoleListResponse, error)
+	RoleUpdate(ctx context.Context, in *RoleUpdateRequest, opts ...grpc.CallOption) (*RoleUpdateResponse, error)
+	RoleDelete(ctx context.Context, in *RoleDeleteRequest, opts ...grpc.CallOption) (*RoleDeleteResponse, error)
+	AuditList(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListResponse, error)
 }
 
 type adminClient struct {
@@ -38,6 +53,33 @@ func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
 	return &adminClient{cc}
 }
 
+func (c *adminClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error) {
+	out := new(RefreshResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/Refresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) UserCreate(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*UserCreateResponse, error) {
 	out := new(UserCreateResponse)
 	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/UserCreate", in, out, opts...)
@@ -74,6 +116,72 @@ func (c *adminClient) UsersAdd(ctx context.Context, in *UsersAddRequest, opts ..
 	return out, nil
 }
 
+func (c *adminClient) UsersExport(ctx context.Context, in *UsersExportRequest, opts ...grpc.CallOption) (Admin_UsersExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[0], "/ozon.dev.vldem.hw2.api.Admin/UsersExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminUsersExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Admin_UsersExportClient interface {
+	Recv() (*UsersExportResponse, error)
+	grpc.ClientStream
+}
+
+type adminUsersExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminUsersExportClient) Recv() (*UsersExportResponse, error) {
+	m := new(UsersExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) UsersImport(ctx context.Context, opts ...grpc.CallOption) (Admin_UsersImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[1], "/ozon.dev.vldem.hw2.api.Admin/UsersImport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminUsersImportClient{stream}
+	return x, nil
+}
+
+type Admin_UsersImportClient interface {
+	Send(*UsersImportRequest) error
+	CloseAndRecv() (*UsersImportResponse, error)
+	grpc.ClientStream
+}
+
+type adminUsersImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminUsersImportClient) Send(m *UsersImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *adminUsersImportClient) CloseAndRecv() (*UsersImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UsersImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *adminClient) UserUpdate(ctx context.Context, in *UserUpdateRequest, opts ...grpc.CallOption) (*UserUpdateResponse, error) {
 	out := new(UserUpdateResponse)
 	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/UserUpdate", in, out, opts...)
@@ -83,6 +191,15 @@ func (c *adminClient) UserUpdate(ctx context.Context, in *UserUpdateRequest, opt
 	return out, nil
 }
 
+func (c *adminClient) UserPatch(ctx context.Context, in *UserPatchRequest, opts ...grpc.CallOption) (*UserPatchResponse, error) {
+	out := new(UserPatchResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/UserPatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) UserDelete(ctx context.Context, in *UserDeleteRequest, opts ...grpc.CallOption) (*UserDeleteResponse, error) {
 	out := new(UserDeleteResponse)
 	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/UserDelete", in, out, opts...)
@@ -92,16 +209,94 @@ func (c *adminClient) UserDelete(ctx context.Context, in *UserDeleteRequest, opt
 	return out, nil
 }
 
+func (c *adminClient) UserRestore(ctx context.Context, in *UserRestoreRequest, opts ...grpc.CallOption) (*UserRestoreResponse, error) {
+	out := new(UserRestoreResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/UserRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UserPurge(ctx context.Context, in *UserPurgeRequest, opts ...grpc.CallOption) (*UserPurgeResponse, error) {
+	out := new(UserPurgeResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/UserPurge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RoleCreate(ctx context.Context, in *RoleCreateRequest, opts ...grpc.CallOption) (*RoleCreateResponse, error) {
+	out := new(RoleCreateResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/RoleCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RoleList(ctx context.Context, in *RoleListRequest, opts ...grpc.CallOption) (*RoleListResponse, error) {
+	out := new(RoleListResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/RoleList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RoleUpdate(ctx context.Context, in *RoleUpdateRequest, opts ...grpc.CallOption) (*RoleUpdateResponse, error) {
+	out := new(RoleUpdateResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/RoleUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RoleDelete(ctx context.Context, in *RoleDeleteRequest, opts ...grpc.CallOption) (*RoleDeleteResponse, error) {
+	out := new(RoleDeleteResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/RoleDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AuditList(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListResponse, error) {
+	out := new(AuditListResponse)
+	err := c.cc.Invoke(ctx, "/ozon.dev.vldem.hw2.api.Admin/AuditList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 // All implementations must embed UnimplementedAdminServer
 // for forward compatibility
 type AdminServer interface {
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
 	UserCreate(context.Context, *UserCreateRequest) (*UserCreateResponse, error)
 	UserGet(context.Context, *UserGetRequest) (*UserGetResponse, error)
 	UserList(context.Context, *UserListRequest) (*UserListResponse, error)
 	UsersAdd(context.Context, *UsersAddRequest) (*UsersAddResponse, error)
+	// Files are passed through REST as they are by GET /v1/users/export and POST /v1/users/import,
+	// these routes are served by the gateway itself
+	UsersExport(*UsersExportRequest, Admin_UsersExportServer) error
+	UsersImport(Admin_UsersImportServer) error
 	UserUpdate(context.Context, *UserUpdateRequest) (*UserUpdateResponse, error)
+	UserPatch(context.Context, *UserPatchRequest) (*UserPatchResponse, error)
 	UserDelete(context.Context, *UserDeleteRequest) (*UserDeleteResponse, error)
+	UserRestore(context.Context, *UserRestoreRequest) (*UserRestoreResponse, error)
+	UserPurge(context.Context, *UserPurgeRequest) (*UserPurgeResponse, error)
+	RoleCreate(context.Context, *RoleCreateRequest) (*RoleCreateResponse, error)
+	RoleList(context.Context, *RoleListRequest) (*RoleListResponse, error)
+	RoleUpdate(context.Context, *RoleUpdateRequest) (*RoleUpdateResponse, error)
+	RoleDelete(context.Context, *RoleDeleteRequest) (*RoleDeleteResponse, error)
+	AuditList(context.Context, *AuditListRequest) (*AuditListResponse, error)
 	mustEmbedUnimplementedAdminServer()
 }
 
@@ -109,6 +304,15 @@ type AdminServer interface {
 type UnimplementedAdminServer struct {
 }
 
+func (UnimplementedAdminServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedAdminServer) Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedAdminServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
 func (UnimplementedAdminServer) UserCreate(context.Context, *UserCreateRequest) (*UserCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserCreate not implemented")
 }
@@ -121,12 +325,42 @@ func (UnimplementedAdminServer) UserList(context.Context, *UserListRequest) (*Us
 func (UnimplementedAdminServer) UsersAdd(context.Context, *UsersAddRequest) (*UsersAddResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UsersAdd not implemented")
 }
+func (UnimplementedAdminServer) UsersExport(*UsersExportRequest, Admin_UsersExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method UsersExport not implemented")
+}
+func (UnimplementedAdminServer) UsersImport(Admin_UsersImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method UsersImport not implemented")
+}
 func (UnimplementedAdminServer) UserUpdate(context.Context, *UserUpdateRequest) (*UserUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserUpdate not implemented")
 }
+func (UnimplementedAdminServer) UserPatch(context.Context, *UserPatchRequest) (*UserPatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPatch not implemented")
+}
 func (UnimplementedAdminServer) UserDelete(context.Context, *UserDeleteRequest) (*UserDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserDelete not implemented")
 }
+func (UnimplementedAdminServer) UserRestore(context.Context, *UserRestoreRequest) (*UserRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRestore not implemented")
+}
+func (UnimplementedAdminServer) UserPurge(context.Context, *UserPurgeRequest) (*UserPurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPurge not implemented")
+}
+func (UnimplementedAdminServer) RoleCreate(context.Context, *RoleCreateRequest) (*RoleCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleCreate not implemented")
+}
+func (UnimplementedAdminServer) RoleList(context.Context, *RoleListRequest) (*RoleListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleList not implemented")
+}
+func (UnimplementedAdminServer) RoleUpdate(context.Context, *RoleUpdateRequest) (*RoleUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleUpdate not implemented")
+}
+func (UnimplementedAdminServer) RoleDelete(context.Context, *RoleDeleteRequest) (*RoleDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleDelete not implemented")
+}
+func (UnimplementedAdminServer) AuditList(context.Context, *AuditListRequest) (*AuditListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditList not implemented")
+}
 func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
 
 // UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -140,6 +374,60 @@ func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
 	s.RegisterService(&Admin_ServiceDesc, srv)
 }
 
+func _Admin_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/Refresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_UserCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserCreateRequest)
 	if err := dec(in); err != nil {
@@ -212,6 +500,53 @@ func _Admin_UsersAdd_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_UsersExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UsersExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).UsersExport(m, &adminUsersExportServer{stream})
+}
+
+type Admin_UsersExportServer interface {
+	Send(*UsersExportResponse) error
+	grpc.ServerStream
+}
+
+type adminUsersExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminUsersExportServer) Send(m *UsersExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Admin_UsersImport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServer).UsersImport(&adminUsersImportServer{stream})
+}
+
+type Admin_UsersImportServer interface {
+	SendAndClose(*UsersImportResponse) error
+	Recv() (*UsersImportRequest, error)
+	grpc.ServerStream
+}
+
+type adminUsersImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminUsersImportServer) SendAndClose(m *UsersImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *adminUsersImportServer) Recv() (*UsersImportRequest, error) {
+	m := new(UsersImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Admin_UserUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserUpdateRequest)
 	if err := dec(in); err != nil {
@@ -230,6 +565,24 @@ func _Admin_UserUpdate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_UserPatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UserPatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/UserPatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UserPatch(ctx, req.(*UserPatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_UserDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserDeleteRequest)
 	if err := dec(in); err != nil {
@@ -248,6 +601,132 @@ func _Admin_UserDelete_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_UserRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UserRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/UserRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UserRestore(ctx, req.(*UserRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UserPurge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UserPurge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/UserPurge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UserPurge(ctx, req.(*UserPurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RoleCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RoleCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/RoleCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RoleCreate(ctx, req.(*RoleCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RoleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RoleList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/RoleList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RoleList(ctx, req.(*RoleListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RoleUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RoleUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/RoleUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RoleUpdate(ctx, req.(*RoleUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RoleDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RoleDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/RoleDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RoleDelete(ctx, req.(*RoleDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AuditList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AuditList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ozon.dev.vldem.hw2.api.Admin/AuditList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AuditList(ctx, req.(*AuditListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,6 +734,18 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "ozon.dev.vldem.hw2.api.Admin",
 	HandlerType: (*AdminServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _Admin_Login_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _Admin_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Admin_Logout_Handler,
+		},
 		{
 			MethodName: "UserCreate",
 			Handler:    _Admin_UserCreate_Handler,
@@ -275,11 +766,54 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserUpdate",
 			Handler:    _Admin_UserUpdate_Handler,
 		},
+		{
+			MethodName: "UserPatch",
+			Handler:    _Admin_UserPatch_Handler,
+		},
 		{
 			MethodName: "UserDelete",
 			Handler:    _Admin_UserDelete_Handler,
 		},
+		{
+			MethodName: "UserRestore",
+			Handler:    _Admin_UserRestore_Handler,
+		},
+		{
+			MethodName: "UserPurge",
+			Handler:    _Admin_UserPurge_Handler,
+		},
+		{
+			MethodName: "RoleCreate",
+			Handler:    _Admin_RoleCreate_Handler,
+		},
+		{
+			MethodName: "RoleList",
+			Handler:    _Admin_RoleList_Handler,
+		},
+		{
+			MethodName: "RoleUpdate",
+			Handler:    _Admin_RoleUpdate_Handler,
+		},
+		{
+			MethodName: "RoleDelete",
+			Handler:    _Admin_RoleDelete_Handler,
+		},
+		{
+			MethodName: "AuditList",
+			Handler:    _Admin_AuditList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UsersExport",
+			Handler:       _Admin_UsersExport_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UsersImport",
+			Handler:       _Admin_UsersImport_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
@@ -0,0 +1,850 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        (unknown)
+// source: queue.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueueRequest is the envelope of requests sent over Kafka. Clients send requests of the Admin service
+// to the client_requests topic, the bot validates them and forwards requests of the Backend service
+// to the ui_request topic. Envelopes are sent with the content-type header application/x-protobuf,
+// messages without the header are JSON of the previous format and are still read during migration.
+type QueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of the envelope, readers reject versions newer than they know
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Name of the gRPC method called by the request
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// Id matching the reply to the request, the same as the correlation-id header
+	CorrelationId string `protobuf:"bytes,3,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// Request of the method: Admin service's one from clients, Backend service's one from the bot
+	//
+	// Types that are assignable to Payload:
+	//	*QueueRequest_UserCreate
+	//	*QueueRequest_UserGet
+	//	*QueueRequest_UserList
+	//	*QueueRequest_UserUpdate
+	//	*QueueRequest_UserDelete
+	//	*QueueRequest_UsersAdd
+	//	*QueueRequest_BackendUserCreate
+	//	*QueueRequest_BackendUserGet
+	//	*QueueRequest_BackendUserList
+	//	*QueueRequest_BackendUserUpdate
+	//	*QueueRequest_BackendUserDelete
+	//	*QueueRequest_BackendUsersAdd
+	Payload isQueueRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *QueueRequest) Reset() {
+	*x = QueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueRequest) ProtoMessage() {}
+
+func (x *QueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueRequest.ProtoReflect.Descriptor instead.
+func (*QueueRequest) Descriptor() ([]byte, []int) {
+	return file_queue_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueueRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *QueueRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *QueueRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (m *QueueRequest) GetPayload() isQueueRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetUserCreate() *UserCreateRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_UserCreate); ok {
+		return x.UserCreate
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetUserGet() *UserGetRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_UserGet); ok {
+		return x.UserGet
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetUserList() *UserListRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_UserList); ok {
+		return x.UserList
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetUserUpdate() *UserUpdateRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_UserUpdate); ok {
+		return x.UserUpdate
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetUserDelete() *UserDeleteRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_UserDelete); ok {
+		return x.UserDelete
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetUsersAdd() *UsersAddRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_UsersAdd); ok {
+		return x.UsersAdd
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetBackendUserCreate() *BackendUserCreateRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_BackendUserCreate); ok {
+		return x.BackendUserCreate
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetBackendUserGet() *BackendUserGetRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_BackendUserGet); ok {
+		return x.BackendUserGet
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetBackendUserList() *BackendUserListRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_BackendUserList); ok {
+		return x.BackendUserList
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetBackendUserUpdate() *BackendUserUpdateRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_BackendUserUpdate); ok {
+		return x.BackendUserUpdate
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetBackendUserDelete() *BackendUserDeleteRequest {
+	if x, ok := x.GetPayload().(*QueueRequest_BackendUserDelete); ok {
+		return x.BackendUserDelete
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetBackendUsersAdd() *QueueRequest_UsersAddBatch {
+	if x, ok := x.GetPayload().(*QueueRequest_BackendUsersAdd); ok {
+		return x.BackendUsersAdd
+	}
+	return nil
+}
+
+type isQueueRequest_Payload interface {
+	isQueueRequest_Payload()
+}
+
+type QueueRequest_UserCreate struct {
+	UserCreate *UserCreateRequest `protobuf:"bytes,10,opt,name=user_create,json=userCreate,proto3,oneof"`
+}
+
+type QueueRequest_UserGet struct {
+	UserGet *UserGetRequest `protobuf:"bytes,11,opt,name=user_get,json=userGet,proto3,oneof"`
+}
+
+type QueueRequest_UserList struct {
+	UserList *UserListRequest `protobuf:"bytes,12,opt,name=user_list,json=userList,proto3,oneof"`
+}
+
+type QueueRequest_UserUpdate struct {
+	UserUpdate *UserUpdateRequest `protobuf:"bytes,13,opt,name=user_update,json=userUpdate,proto3,oneof"`
+}
+
+type QueueRequest_UserDelete struct {
+	UserDelete *UserDeleteRequest `protobuf:"bytes,14,opt,name=user_delete,json=userDelete,proto3,oneof"`
+}
+
+type QueueRequest_UsersAdd struct {
+	UsersAdd *UsersAddRequest `protobuf:"bytes,15,opt,name=users_add,json=usersAdd,proto3,oneof"`
+}
+
+type QueueRequest_BackendUserCreate struct {
+	BackendUserCreate *BackendUserCreateRequest `protobuf:"bytes,20,opt,name=backend_user_create,json=backendUserCreate,proto3,oneof"`
+}
+
+type QueueRequest_BackendUserGet struct {
+	BackendUserGet *BackendUserGetRequest `protobuf:"bytes,21,opt,name=backend_user_get,json=backendUserGet,proto3,oneof"`
+}
+
+type QueueRequest_BackendUserList struct {
+	BackendUserList *BackendUserListRequest `protobuf:"bytes,22,opt,name=backend_user_list,json=backendUserList,proto3,oneof"`
+}
+
+type QueueRequest_BackendUserUpdate struct {
+	BackendUserUpdate *BackendUserUpdateRequest `protobuf:"bytes,23,opt,name=backend_user_update,json=backendUserUpdate,proto3,oneof"`
+}
+
+type QueueRequest_BackendUserDelete struct {
+	BackendUserDelete *BackendUserDeleteRequest `protobuf:"bytes,24,opt,name=backend_user_delete,json=backendUserDelete,proto3,oneof"`
+}
+
+type QueueRequest_BackendUsersAdd struct {
+	BackendUsersAdd *QueueRequest_UsersAddBatch `protobuf:"bytes,25,opt,name=backend_users_add,json=backendUsersAdd,proto3,oneof"`
+}
+
+func (*QueueRequest_UserCreate) isQueueRequest_Payload() {}
+
+func (*QueueRequest_UserGet) isQueueRequest_Payload() {}
+
+func (*QueueRequest_UserList) isQueueRequest_Payload() {}
+
+func (*QueueRequest_UserUpdate) isQueueRequest_Payload() {}
+
+func (*QueueRequest_UserDelete) isQueueRequest_Payload() {}
+
+func (*QueueRequest_UsersAdd) isQueueRequest_Payload() {}
+
+func (*QueueRequest_BackendUserCreate) isQueueRequest_Payload() {}
+
+func (*QueueRequest_BackendUserGet) isQueueRequest_Payload() {}
+
+func (*QueueRequest_BackendUserList) isQueueRequest_Payload() {}
+
+func (*QueueRequest_BackendUserUpdate) isQueueRequest_Payload() {}
+
+func (*QueueRequest_BackendUserDelete) isQueueRequest_Payload() {}
+
+func (*QueueRequest_BackendUsersAdd) isQueueRequest_Payload() {}
+
+// QueueResponse is the envelope of replies to requests sent over Kafka
+type QueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version       uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Command       string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	CorrelationId string `protobuf:"bytes,3,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// Status code of the request, payload is set only if it is OK
+	Code    int32  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are assignable to Payload:
+	//	*QueueResponse_UserCreate
+	//	*QueueResponse_UserGet
+	//	*QueueResponse_UserList
+	//	*QueueResponse_UserUpdate
+	//	*QueueResponse_UserDelete
+	//	*QueueResponse_UsersAdd
+	Payload isQueueResponse_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *QueueResponse) Reset() {
+	*x = QueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueResponse) ProtoMessage() {}
+
+func (x *QueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueResponse.ProtoReflect.Descriptor instead.
+func (*QueueResponse) Descriptor() ([]byte, []int) {
+	return file_queue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueueResponse) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *QueueResponse) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *QueueResponse) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *QueueResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *QueueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (m *QueueResponse) GetPayload() isQueueResponse_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *QueueResponse) GetUserCreate() *BackendUserCreateResponse {
+	if x, ok := x.GetPayload().(*QueueResponse_UserCreate); ok {
+		return x.UserCreate
+	}
+	return nil
+}
+
+func (x *QueueResponse) GetUserGet() *BackendUserGetResponse {
+	if x, ok := x.GetPayload().(*QueueResponse_UserGet); ok {
+		return x.UserGet
+	}
+	return nil
+}
+
+func (x *QueueResponse) GetUserList() *BackendUserListResponse {
+	if x, ok := x.GetPayload().(*QueueResponse_UserList); ok {
+		return x.UserList
+	}
+	return nil
+}
+
+func (x *QueueResponse) GetUserUpdate() *BackendUserUpdateResponse {
+	if x, ok := x.GetPayload().(*QueueResponse_UserUpdate); ok {
+		return x.UserUpdate
+	}
+	return nil
+}
+
+func (x *QueueResponse) GetUserDelete() *BackendUserDeleteResponse {
+	if x, ok := x.GetPayload().(*QueueResponse_UserDelete); ok {
+		return x.UserDelete
+	}
+	return nil
+}
+
+func (x *QueueResponse) GetUsersAdd() *QueueResponse_UsersAddResults {
+	if x, ok := x.GetPayload().(*QueueResponse_UsersAdd); ok {
+		return x.UsersAdd
+	}
+	return nil
+}
+
+type isQueueResponse_Payload interface {
+	isQueueResponse_Payload()
+}
+
+type QueueResponse_UserCreate struct {
+	UserCreate *BackendUserCreateResponse `protobuf:"bytes,10,opt,name=user_create,json=userCreate,proto3,oneof"`
+}
+
+type QueueResponse_UserGet struct {
+	UserGet *BackendUserGetResponse `protobuf:"bytes,11,opt,name=user_get,json=userGet,proto3,oneof"`
+}
+
+type QueueResponse_UserList struct {
+	UserList *BackendUserListResponse `protobuf:"bytes,12,opt,name=user_list,json=userList,proto3,oneof"`
+}
+
+type QueueResponse_UserUpdate struct {
+	UserUpdate *BackendUserUpdateResponse `protobuf:"bytes,13,opt,name=user_update,json=userUpdate,proto3,oneof"`
+}
+
+type QueueResponse_UserDelete struct {
+	UserDelete *BackendUserDeleteResponse `protobuf:"bytes,14,opt,name=user_delete,json=userDelete,proto3,oneof"`
+}
+
+type QueueResponse_UsersAdd struct {
+	UsersAdd *QueueResponse_UsersAddResults `protobuf:"bytes,15,opt,name=users_add,json=usersAdd,proto3,oneof"`
+}
+
+func (*QueueResponse_UserCreate) isQueueResponse_Payload() {}
+
+func (*QueueResponse_UserGet) isQueueResponse_Payload() {}
+
+func (*QueueResponse_UserList) isQueueResponse_Payload() {}
+
+func (*QueueResponse_UserUpdate) isQueueResponse_Payload() {}
+
+func (*QueueResponse_UserDelete) isQueueResponse_Payload() {}
+
+func (*QueueResponse_UsersAdd) isQueueResponse_Payload() {}
+
+// Users of UsersAdd are sent to the backend in one message
+type QueueRequest_UsersAddBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*BackendUsersAddRequest `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *QueueRequest_UsersAddBatch) Reset() {
+	*x = QueueRequest_UsersAddBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueRequest_UsersAddBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueRequest_UsersAddBatch) ProtoMessage() {}
+
+func (x *QueueRequest_UsersAddBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueRequest_UsersAddBatch.ProtoReflect.Descriptor instead.
+func (*QueueRequest_UsersAddBatch) Descriptor() ([]byte, []int) {
+	return file_queue_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *QueueRequest_UsersAddBatch) GetUsers() []*BackendUsersAddRequest {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Results of UsersAdd in the same order as users of the request
+type QueueResponse_UsersAddResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*BackendUsersAddResponse `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *QueueResponse_UsersAddResults) Reset() {
+	*x = QueueResponse_UsersAddResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueResponse_UsersAddResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueResponse_UsersAddResults) ProtoMessage() {}
+
+func (x *QueueResponse_UsersAddResults) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueResponse_UsersAddResults.ProtoReflect.Descriptor instead.
+func (*QueueResponse_UsersAddResults) Descriptor() ([]byte, []int) {
+	return file_queue_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *QueueResponse_UsersAddResults) GetUsers() []*BackendUsersAddResponse {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+var File_queue_proto protoreflect.FileDescriptor
+
+var file_queue_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x11, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x09, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x4c, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x67, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d,
+	0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76,
+	0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0b, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d,
+	0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68,
+	0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f,
+	0x61, 0x64, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x12, 0x62,
+	0x0a, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x7a,
+	0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x11, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x67, 0x65, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x74, 0x12, 0x5c, 0x0a,
+	0x11, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x13, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x62, 0x0a, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f,
+	0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e,
+	0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x48, 0x00, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x41, 0x64, 0x64, 0x1a, 0x55, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64,
+	0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x44, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76,
+	0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x42, 0x09, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xf2, 0x05, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64,
+	0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x67, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x7a, 0x6f,
+	0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x47, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x7a, 0x6f,
+	0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65,
+	0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x54, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x00, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x1a, 0x58, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2e, 0x68, 0x77, 0x32, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x2d, 0x5a, 0x2b,
+	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x64, 0x65, 0x76, 0x2f,
+	0x76, 0x6c, 0x64, 0x65, 0x6d, 0x2f, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x31, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_queue_proto_rawDescOnce sync.Once
+	file_queue_proto_rawDescData = file_queue_proto_rawDesc
+)
+
+func file_queue_proto_rawDescGZIP() []byte {
+	file_queue_proto_rawDescOnce.Do(func() {
+		file_queue_proto_rawDescData = protoimpl.X.CompressGZIP(file_queue_proto_rawDescData)
+	})
+	return file_queue_proto_rawDescData
+}
+
+var file_queue_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_queue_proto_goTypes = []interface{}{
+	(*QueueRequest)(nil),                  // 0: ozon.dev.vldem.hw2.api.QueueRequest
+	(*QueueResponse)(nil),                 // 1: ozon.dev.vldem.hw2.api.QueueResponse
+	(*QueueRequest_UsersAddBatch)(nil),    // 2: ozon.dev.vldem.hw2.api.QueueRequest.UsersAddBatch
+	(*QueueResponse_UsersAddResults)(nil), // 3: ozon.dev.vldem.hw2.api.QueueResponse.UsersAddResults
+	(*UserCreateRequest)(nil),             // 4: ozon.dev.vldem.hw2.api.UserCreateRequest
+	(*UserGetRequest)(nil),                // 5: ozon.dev.vldem.hw2.api.UserGetRequest
+	(*UserListRequest)(nil),               // 6: ozon.dev.vldem.hw2.api.UserListRequest
+	(*UserUpdateRequest)(nil),             // 7: ozon.dev.vldem.hw2.api.UserUpdateRequest
+	(*UserDeleteRequest)(nil),             // 8: ozon.dev.vldem.hw2.api.UserDeleteRequest
+	(*UsersAddRequest)(nil),               // 9: ozon.dev.vldem.hw2.api.UsersAddRequest
+	(*BackendUserCreateRequest)(nil),      // 10: ozon.dev.vldem.hw2.api.BackendUserCreateRequest
+	(*BackendUserGetRequest)(nil),         // 11: ozon.dev.vldem.hw2.api.BackendUserGetRequest
+	(*BackendUserListRequest)(nil),        // 12: ozon.dev.vldem.hw2.api.BackendUserListRequest
+	(*BackendUserUpdateRequest)(nil),      // 13: ozon.dev.vldem.hw2.api.BackendUserUpdateRequest
+	(*BackendUserDeleteRequest)(nil),      // 14: ozon.dev.vldem.hw2.api.BackendUserDeleteRequest
+	(*BackendUserCreateResponse)(nil),     // 15: ozon.dev.vldem.hw2.api.BackendUserCreateResponse
+	(*BackendUserGetResponse)(nil),        // 16: ozon.dev.vldem.hw2.api.BackendUserGetResponse
+	(*BackendUserListResponse)(nil),       // 17: ozon.dev.vldem.hw2.api.BackendUserListResponse
+	(*BackendUserUpdateResponse)(nil),     // 18: ozon.dev.vldem.hw2.api.BackendUserUpdateResponse
+	(*BackendUserDeleteResponse)(nil),     // 19: ozon.dev.vldem.hw2.api.BackendUserDeleteResponse
+	(*BackendUsersAddRequest)(nil),        // 20: ozon.dev.vldem.hw2.api.BackendUsersAddRequest
+	(*BackendUsersAddResponse)(nil),       // 21: ozon.dev.vldem.hw2.api.BackendUsersAddResponse
+}
+var file_queue_proto_depIdxs = []int32{
+	4,  // 0: ozon.dev.vldem.hw2.api.QueueRequest.user_create:type_name -> ozon.dev.vldem.hw2.api.UserCreateRequest
+	5,  // 1: ozon.dev.vldem.hw2.api.QueueRequest.user_get:type_name -> ozon.dev.vldem.hw2.api.UserGetRequest
+	6,  // 2: ozon.dev.vldem.hw2.api.QueueRequest.user_list:type_name -> ozon.dev.vldem.hw2.api.UserListRequest
+	7,  // 3: ozon.dev.vldem.hw2.api.QueueRequest.user_update:type_name -> ozon.dev.vldem.hw2.api.UserUpdateRequest
+	8,  // 4: ozon.dev.vldem.hw2.api.QueueRequest.user_delete:type_name -> ozon.dev.vldem.hw2.api.UserDeleteRequest
+	9,  // 5: ozon.dev.vldem.hw2.api.QueueRequest.users_add:type_name -> ozon.dev.vldem.hw2.api.UsersAddRequest
+	10, // 6: ozon.dev.vldem.hw2.api.QueueRequest.backend_user_create:type_name -> ozon.dev.vldem.hw2.api.BackendUserCreateRequest
+	11, // 7: ozon.dev.vldem.hw2.api.QueueRequest.backend_user_get:type_name -> ozon.dev.vldem.hw2.api.BackendUserGetRequest
+	12, // 8: ozon.dev.vldem.hw2.api.QueueRequest.backend_user_list:type_name -> ozon.dev.vldem.hw2.api.BackendUserListRequest
+	13, // 9: ozon.dev.vldem.hw2.api.QueueRequest.backend_user_update:type_name -> ozon.dev.vldem.hw2.api.BackendUserUpdateRequest
+	14, // 10: ozon.dev.vldem.hw2.api.QueueRequest.backend_user_delete:type_name -> ozon.dev.vldem.hw2.api.BackendUserDeleteRequest
+	2,  // 11: ozon.dev.vldem.hw2.api.QueueRequest.backend_users_add:type_name -> ozon.dev.vldem.hw2.api.QueueRequest.UsersAddBatch
+	15, // 12: ozon.dev.vldem.hw2.api.QueueResponse.user_create:type_name -> ozon.dev.vldem.hw2.api.BackendUserCreateResponse
+	16, // 13: ozon.dev.vldem.hw2.api.QueueResponse.user_get:type_name -> ozon.dev.vldem.hw2.api.BackendUserGetResponse
+	17, // 14: ozon.dev.vldem.hw2.api.QueueResponse.user_list:type_name -> ozon.dev.vldem.hw2.api.BackendUserListResponse
+	18, // 15: ozon.dev.vldem.hw2.api.QueueResponse.user_update:type_name -> ozon.dev.vldem.hw2.api.BackendUserUpdateResponse
+	19, // 16: ozon.dev.vldem.hw2.api.QueueResponse.user_delete:type_name -> ozon.dev.vldem.hw2.api.BackendUserDeleteResponse
+	3,  // 17: ozon.dev.vldem.hw2.api.QueueResponse.users_add:type_name -> ozon.dev.vldem.hw2.api.QueueResponse.UsersAddResults
+	20, // 18: ozon.dev.vldem.hw2.api.QueueRequest.UsersAddBatch.users:type_name -> ozon.dev.vldem.hw2.api.BackendUsersAddRequest
+	21, // 19: ozon.dev.vldem.hw2.api.QueueResponse.UsersAddResults.users:type_name -> ozon.dev.vldem.hw2.api.BackendUsersAddResponse
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_queue_proto_init() }
+func file_queue_proto_init() {
+	if File_queue_proto != nil {
+		return
+	}
+	file_api_proto_init()
+	file_api_backend_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_queue_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueRequest_UsersAddBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueResponse_UsersAddResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_queue_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*QueueRequest_UserCreate)(nil),
+		(*QueueRequest_UserGet)(nil),
+		(*QueueRequest_UserList)(nil),
+		(*QueueRequest_UserUpdate)(nil),
+		(*QueueRequest_UserDelete)(nil),
+		(*QueueRequest_UsersAdd)(nil),
+		(*QueueRequest_BackendUserCreate)(nil),
+		(*QueueRequest_BackendUserGet)(nil),
+		(*QueueRequest_BackendUserList)(nil),
+		(*QueueRequest_BackendUserUpdate)(nil),
+		(*QueueRequest_BackendUserDelete)(nil),
+		(*QueueRequest_BackendUsersAdd)(nil),
+	}
+	file_queue_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*QueueResponse_UserCreate)(nil),
+		(*QueueResponse_UserGet)(nil),
+		(*QueueResponse_UserList)(nil),
+		(*QueueResponse_UserUpdate)(nil),
+		(*QueueResponse_UserDelete)(nil),
+		(*QueueResponse_UsersAdd)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_queue_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_queue_proto_goTypes,
+		DependencyIndexes: file_queue_proto_depIdxs,
+		MessageInfos:      file_queue_proto_msgTypes,
+	}.Build()
+	File_queue_proto = out.File
+	file_queue_proto_rawDesc = nil
+	file_queue_proto_goTypes = nil
+	file_queue_proto_depIdxs = nil
+}
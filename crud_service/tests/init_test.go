@@ -4,8 +4,12 @@
 package tests
 
 import (
+	"context"
 	"time"
 
+	"gitlab.ozon.dev/vldem/homework1/internal/auth"
+	configPkg "gitlab.ozon.dev/vldem/homework1/internal/config"
+	"gitlab.ozon.dev/vldem/homework1/internal/pkg/core/user/models"
 	pb "gitlab.ozon.dev/vldem/homework1/pkg/api"
 	"gitlab.ozon.dev/vldem/homework1/tests/config"
 	"gitlab.ozon.dev/vldem/homework1/tests/postgres"
@@ -20,7 +24,23 @@ var (
 func init() {
 	cfg, err := config.FromEnv()
 
-	conn, err := grpc.Dial(cfg.Host, grpc.WithInsecure(), grpc.WithTimeout(3*time.Second))
+	// tests are run on behalf of admin with token signed by the services' secret
+	tokens, err := auth.NewTokenManager(
+		configPkg.TokenSecret,
+		configPkg.TokenIssuer,
+		configPkg.AccessTokenTTL,
+		configPkg.RefreshTokenTTL,
+		nil,
+	).Issue(models.User{Id: 1, Email: "admin@dummy.com", Role: "Admin"})
+	if err != nil {
+		panic(err)
+	}
+
+	conn, err := grpc.Dial(cfg.Host, grpc.WithInsecure(), grpc.WithTimeout(3*time.Second),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(auth.ContextWithToken(ctx, tokens.AccessToken), method, req, reply, cc, opts...)
+		}),
+	)
 	if err != nil {
 		panic(err)
 	}